@@ -0,0 +1,34 @@
+package main
+
+import (
+	"log"
+
+	"github.com/romshark/gapi/compiler"
+	"github.com/romshark/gapi/diff"
+)
+
+// runDiff executes the diff subcommand
+func runDiff(args []string) {
+	if len(args) != 2 {
+		log.Fatal("usage: gapi diff <old schema> <new schema>")
+	}
+
+	from, _, err := compiler.Compile(readSource(args[0]))
+	if err != nil {
+		log.Fatalf("compiler: %s: %s", args[0], err)
+	}
+	to, _, err := compiler.Compile(readSource(args[1]))
+	if err != nil {
+		log.Fatalf("compiler: %s: %s", args[1], err)
+	}
+
+	changes := diff.Compare(from, to)
+	for _, change := range changes {
+		log.Print(change)
+	}
+	if diff.HasBreaking(changes) {
+		log.Fatal("diff: breaking changes detected")
+	}
+
+	log.Print("SUCCESS")
+}
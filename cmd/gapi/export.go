@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/romshark/gapi/graphql"
+	"github.com/romshark/gapi/openapi"
+)
+
+// runExport executes the export subcommand converting the schema
+// to the schema language selected by the first argument
+func runExport(args []string) {
+	if len(args) < 1 {
+		log.Fatal(
+			"missing target schema language " +
+				"(usage: gapi export graphql|openapi|jsonschema)",
+		)
+	}
+	switch args[0] {
+	case "graphql":
+		flags := flag.NewFlagSet("export graphql", flag.ExitOnError)
+		schemaFilePath := flags.String("schema", "", "schema file path")
+		outFilePath := flags.String(
+			"out",
+			"",
+			"output file path (stdout by default)",
+		)
+		flags.Parse(args[1:])
+
+		mod := compileSchema(*schemaFilePath)
+		sdl, issues, err := graphql.Export(mod)
+		if err != nil {
+			log.Fatalf("export: %s", err)
+		}
+		for _, issue := range issues {
+			log.Print("WARNING: ", issue)
+		}
+		writeOutput(*outFilePath, sdl)
+	case "openapi":
+		flags := flag.NewFlagSet("export openapi", flag.ExitOnError)
+		schemaFilePath := flags.String("schema", "", "schema file path")
+		outFilePath := flags.String(
+			"out",
+			"",
+			"output file path (stdout by default)",
+		)
+		apiVersion := flags.String(
+			"version",
+			openapi.DefaultAPIVersion,
+			"API version",
+		)
+		flags.Parse(args[1:])
+
+		mod := compileSchema(*schemaFilePath)
+		doc, issues, err := openapi.Export(mod, openapi.Options{
+			APIVersion: *apiVersion,
+		})
+		if err != nil {
+			log.Fatalf("export: %s", err)
+		}
+		for _, issue := range issues {
+			log.Print("WARNING: ", issue)
+		}
+		writeOutput(*outFilePath, doc)
+	case "jsonschema":
+		flags := flag.NewFlagSet("export jsonschema", flag.ExitOnError)
+		schemaFilePath := flags.String("schema", "", "schema file path")
+		outFilePath := flags.String(
+			"out",
+			"",
+			"output file path (stdout by default)",
+		)
+		flags.Parse(args[1:])
+
+		mod := compileSchema(*schemaFilePath)
+		doc, err := openapi.JSONSchema(mod)
+		if err != nil {
+			log.Fatalf("export: %s", err)
+		}
+		writeOutput(*outFilePath, doc)
+	default:
+		log.Fatalf("unsupported target schema language: %s", args[0])
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/romshark/gapi/compiler"
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator/golang"
+	"github.com/romshark/gapi/generator/typescript"
+)
+
+// runGen executes the gen subcommand generating code
+// in the language selected by the first argument
+func runGen(args []string) {
+	if len(args) < 1 {
+		log.Fatal("missing target language (usage: gapi gen go|ts)")
+	}
+	switch args[0] {
+	case "go":
+		flags := flag.NewFlagSet("gen go", flag.ExitOnError)
+		schemaFilePath := flags.String("schema", "", "schema file path")
+		outFilePath := flags.String(
+			"out",
+			"",
+			"output file path (stdout by default)",
+		)
+		packageName := flags.String(
+			"package",
+			golang.DefaultPackageName,
+			"Go package name",
+		)
+		flags.Parse(args[1:])
+
+		mod := compileSchema(*schemaFilePath)
+		src, err := golang.Generate(mod, golang.Options{
+			PackageName: *packageName,
+		})
+		if err != nil {
+			log.Fatalf("generator: %s", err)
+		}
+		writeOutput(*outFilePath, src)
+	case "ts":
+		flags := flag.NewFlagSet("gen ts", flag.ExitOnError)
+		schemaFilePath := flags.String("schema", "", "schema file path")
+		outFilePath := flags.String(
+			"out",
+			"",
+			"output file path (stdout by default)",
+		)
+		flags.Parse(args[1:])
+
+		mod := compileSchema(*schemaFilePath)
+		src, err := typescript.Generate(mod)
+		if err != nil {
+			log.Fatalf("generator: %s", err)
+		}
+		writeOutput(*outFilePath, src)
+	default:
+		log.Fatalf("unsupported target language: %s", args[0])
+	}
+}
+
+// compileSchema compiles the schema file
+// printing warnings and exiting on failure
+func compileSchema(path string) *parser.SchemaModel {
+	if path == "" {
+		log.Fatal("missing schema file path (use -schema)")
+	}
+	mod, warnings, err := compiler.Compile(readSource(path))
+	for _, warning := range warnings {
+		log.Print("WARNING: ", warning)
+	}
+	if err != nil {
+		log.Fatalf("compiler: %s", err)
+	}
+	return mod
+}
+
+// writeOutput writes the generated output to the given file
+// or to stdout if no file path is given
+func writeOutput(path string, output []byte) {
+	if path == "" {
+		if _, err := os.Stdout.Write(output); err != nil {
+			log.Fatalf("writing output: %s", err)
+		}
+		return
+	}
+	if err := ioutil.WriteFile(path, output, 0644); err != nil {
+		log.Fatalf("writing output file: %s", err)
+	}
+}
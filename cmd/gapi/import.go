@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+
+	"github.com/romshark/gapi/graphql"
+)
+
+// runImport executes the import subcommand converting a schema
+// of the schema language selected by the first argument to GAPI
+func runImport(args []string) {
+	if len(args) < 1 {
+		log.Fatal("missing source schema language (usage: gapi import graphql)")
+	}
+	switch args[0] {
+	case "graphql":
+		flags := flag.NewFlagSet("import graphql", flag.ExitOnError)
+		sdlFilePath := flags.String("sdl", "", "GraphQL SDL file path")
+		schemaName := flags.String("name", "", "GAPI schema name")
+		outFilePath := flags.String(
+			"out",
+			"",
+			"output file path (stdout by default)",
+		)
+		flags.Parse(args[1:])
+
+		if *sdlFilePath == "" {
+			log.Fatal("missing SDL file path (use -sdl)")
+		}
+		if *schemaName == "" {
+			log.Fatal("missing schema name (use -name)")
+		}
+		sdl, err := ioutil.ReadFile(*sdlFilePath)
+		if err != nil {
+			log.Fatalf("reading file: %s", err)
+		}
+
+		_, src, issues, err := graphql.Import(*schemaName, string(sdl))
+		for _, issue := range issues {
+			log.Print("WARNING: ", issue)
+		}
+		if src != nil {
+			// Write the converted schema even if it doesn't compile
+			// to allow fixing it manually
+			writeOutput(*outFilePath, src)
+		}
+		if err != nil {
+			log.Fatalf("import: %s", err)
+		}
+	default:
+		log.Fatalf("unsupported source schema language: %s", args[0])
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/lint"
+)
+
+// runLint executes the lint subcommand
+func runLint(args []string) {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	schemaFilePath := flags.String("schema", "", "schema file path")
+	configFilePath := flags.String("config", "", "lint configuration file path")
+	werror := flags.Bool("werror", false, "treat warnings as errors")
+	flags.Parse(args)
+
+	log.Print("SCHEMA: ", *schemaFilePath)
+	if *schemaFilePath == "" {
+		log.Fatal("missing schema file path (use -schema)")
+	}
+
+	// Load configuration
+	config := lint.Config{}
+	if *configFilePath != "" {
+		var err error
+		if config, err = lint.LoadConfig(*configFilePath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	linter, err := lint.New(config, lint.Builtin()...)
+	if err != nil {
+		log.Fatalf("linter: %s", err)
+	}
+
+	// Compile
+	pr, err := parser.NewParser()
+	if err != nil {
+		log.Fatalf("compiler: %s", err)
+	}
+	if err := pr.Parse(readSource(*schemaFilePath)); err != nil {
+		log.Fatalf("compiler: %s", err)
+	}
+
+	// Lint
+	violations := linter.Lint(&lint.Schema{
+		Model:     pr.SchemaModel(),
+		Fragments: pr.Fragments(),
+	})
+	failed := 0
+	for _, violation := range violations {
+		if violation.Severity == parser.SeverityError || *werror {
+			failed++
+			log.Print("ERROR: ", violation)
+			continue
+		}
+		log.Print("WARNING: ", violation)
+	}
+	if failed > 0 {
+		log.Fatalf("linter: %d violations", failed)
+	}
+
+	log.Print("SUCCESS")
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"io/ioutil"
 	"log"
+	"os"
 	"path/filepath"
 
 	"github.com/romshark/gapi/compiler"
@@ -11,8 +13,34 @@ import (
 )
 
 var schemaFilePath = flag.String("schema", "", "schema file path")
+var werror = flag.Bool("werror", false, "treat warnings as errors")
+var idLockFilePath = flag.String(
+	"idlock",
+	"",
+	"ID lock file path (created if missing, updated on success)",
+)
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			runLint(os.Args[2:])
+			return
+		case "diff":
+			runDiff(os.Args[2:])
+			return
+		case "gen":
+			runGen(os.Args[2:])
+			return
+		case "export":
+			runExport(os.Args[2:])
+			return
+		case "import":
+			runImport(os.Args[2:])
+			return
+		}
+	}
+
 	flag.Parse()
 
 	log.Print("SCHEMA: ", *schemaFilePath)
@@ -20,24 +48,71 @@ func main() {
 		log.Fatal("missing schema file path (use -schema)")
 	}
 
-	// Load schema file
-	fileContents, err := ioutil.ReadFile(*schemaFilePath)
+	// Compiler
+	ast, lock, warnings, err := compiler.CompileLocked(
+		readSource(*schemaFilePath),
+		readIDLock(*idLockFilePath),
+	)
+	for _, warning := range warnings {
+		log.Print("WARNING: ", warning)
+	}
 	if err != nil {
-		log.Fatalf("reading file: %s", err)
+		log.Fatalf("compiler: %s", err)
+	}
+	if *werror && len(warnings) > 0 {
+		log.Fatalf("compiler: %d warnings treated as errors", len(warnings))
 	}
 
-	// Compiler
-	ast, err := compiler.Compile(parser.SourceFile{
+	if *idLockFilePath != "" {
+		writeIDLock(*idLockFilePath, lock)
+	}
+
+	log.Print("SUCCESS: ", ast)
+	log.Print("SCHEMA NAME: ", ast.SchemaName)
+}
+
+// readSource loads the schema file
+func readSource(path string) parser.SourceFile {
+	fileContents, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatalf("reading file: %s", err)
+	}
+	return parser.SourceFile{
 		File: parser.File{
-			Name: filepath.Base(*schemaFilePath),
-			Path: filepath.Dir(*schemaFilePath),
+			Name: filepath.Base(path),
+			Path: filepath.Dir(path),
 		},
 		Src: string(fileContents),
-	})
+	}
+}
+
+// readIDLock loads the ID lock file returning nil
+// if no path is given or the file doesn't yet exist
+func readIDLock(path string) *parser.IDLock {
+	if path == "" {
+		return nil
+	}
+	fileContents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
 	if err != nil {
-		log.Fatalf("compiler: %s", err)
+		log.Fatalf("reading ID lock file: %s", err)
 	}
+	lock := &parser.IDLock{}
+	if err := json.Unmarshal(fileContents, lock); err != nil {
+		log.Fatalf("parsing ID lock file: %s", err)
+	}
+	return lock
+}
 
-	log.Print("SUCCESS: ", ast)
-	log.Print("SCHEMA NAME: ", ast.SchemaName)
+// writeIDLock writes the ID lock file
+func writeIDLock(path string, lock *parser.IDLock) {
+	encoded, err := json.MarshalIndent(lock, "", "\t")
+	if err != nil {
+		log.Fatalf("encoding ID lock: %s", err)
+	}
+	if err := ioutil.WriteFile(path, append(encoded, '\n'), 0644); err != nil {
+		log.Fatalf("writing ID lock file: %s", err)
+	}
 }
@@ -3,13 +3,38 @@ package compiler
 import "github.com/romshark/gapi/compiler/parser"
 
 // Compile compiles the source file returning an abstract syntax tree
-func Compile(source parser.SourceFile) (*parser.SchemaModel, error) {
+// and the compiler warnings
+func Compile(source parser.SourceFile) (
+	*parser.SchemaModel,
+	[]parser.Error,
+	error,
+) {
 	parser, err := parser.NewParser()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := parser.Parse(source); err != nil {
-		return nil, err
+		return nil, parser.Warnings(), err
 	}
-	return parser.SchemaModel(), nil
+	return parser.SchemaModel(), parser.Warnings(), nil
+}
+
+// CompileLocked compiles the source file keeping the identifiers pinned
+// by the given ID lock stable returning an abstract syntax tree,
+// the updated ID lock and the compiler warnings
+func CompileLocked(source parser.SourceFile, lock *parser.IDLock) (
+	*parser.SchemaModel,
+	*parser.IDLock,
+	[]parser.Error,
+	error,
+) {
+	parser, err := parser.NewParser()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	parser.SetIDLock(lock)
+	if err := parser.Parse(source); err != nil {
+		return nil, nil, parser.Warnings(), err
+	}
+	return parser.SchemaModel(), parser.IDLock(), parser.Warnings(), nil
 }
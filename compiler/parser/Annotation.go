@@ -0,0 +1,29 @@
+package parser
+
+// Annotation represents an annotation of a declaration
+type Annotation struct {
+	Src  Fragment
+	Name string
+	Args []*Value
+}
+
+// AnnotationParam represents a parameter of a known annotation
+type AnnotationParam struct {
+	Name string
+
+	// Type is the type the argument must match
+	Type Type
+
+	// Optional parameters can be omitted.
+	// Optional parameters must not be followed by required ones
+	Optional bool
+}
+
+// AnnotationSchema describes a known annotation
+type AnnotationSchema struct {
+	Name   string
+	Params []AnnotationParam
+
+	// Repeatable annotations can be applied to a declaration more than once
+	Repeatable bool
+}
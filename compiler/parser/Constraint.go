@@ -0,0 +1,69 @@
+package parser
+
+// ConstraintKind represents the kind of a value constraint
+type ConstraintKind int
+
+const (
+	_ ConstraintKind = iota
+
+	// ConstraintMin constrains numbers to a minimum value
+	ConstraintMin
+
+	// ConstraintMax constrains numbers to a maximum value
+	ConstraintMax
+
+	// ConstraintMinLength constrains strings, lists and maps
+	// to a minimum length
+	ConstraintMinLength
+
+	// ConstraintMaxLength constrains strings, lists and maps
+	// to a maximum length
+	ConstraintMaxLength
+
+	// ConstraintPattern constrains strings to a regular expression
+	ConstraintPattern
+
+	// ConstraintNonEmpty constrains strings, lists and maps to be non-empty
+	ConstraintNonEmpty
+)
+
+// String stringifies the constraint kind
+func (k ConstraintKind) String() string {
+	switch k {
+	case ConstraintMin:
+		return "min"
+	case ConstraintMax:
+		return "max"
+	case ConstraintMinLength:
+		return "minLength"
+	case ConstraintMaxLength:
+		return "maxLength"
+	case ConstraintPattern:
+		return "pattern"
+	case ConstraintNonEmpty:
+		return "nonEmpty"
+	}
+	return ""
+}
+
+// constraintKindByName returns the constraint kind of the given name
+// or 0 if there's none
+func constraintKindByName(name string) ConstraintKind {
+	for k := ConstraintMin; k <= ConstraintNonEmpty; k++ {
+		if k.String() == name {
+			return k
+		}
+	}
+	return 0
+}
+
+// Constraint represents a value constraint
+// of either a struct field or a parameter
+type Constraint struct {
+	Src  Fragment
+	Kind ConstraintKind
+
+	// Value is the argument of the constraint,
+	// it's nil for constraints without arguments
+	Value *Value
+}
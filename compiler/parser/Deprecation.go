@@ -0,0 +1,12 @@
+package parser
+
+// Deprecation represents a deprecation marker
+type Deprecation struct {
+	Src Fragment
+
+	// Reason describes why the declaration was deprecated
+	Reason string
+
+	// Replacement optionally names the declaration to be used instead
+	Replacement string
+}
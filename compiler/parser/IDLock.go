@@ -0,0 +1,67 @@
+package parser
+
+// IDLock pins the unique identifiers of types, graph nodes and parameters
+// to their designations to keep them stable across schema versions.
+// Types are designated by their names, graph nodes by their graph node
+// names and parameters by the graph node name of their target followed
+// by a dot and the name of the parameter (e.g. "User.friends.limit")
+type IDLock struct {
+	Types      map[string]TypeID      `json:"types"`
+	GraphNodes map[string]GraphNodeID `json:"graph-nodes"`
+	Params     map[string]ParamID     `json:"params"`
+}
+
+// Clone returns a copy of the lock
+func (l *IDLock) Clone() *IDLock {
+	if l == nil {
+		return nil
+	}
+	c := &IDLock{
+		Types:      make(map[string]TypeID, len(l.Types)),
+		GraphNodes: make(map[string]GraphNodeID, len(l.GraphNodes)),
+		Params:     make(map[string]ParamID, len(l.Params)),
+	}
+	for k, v := range l.Types {
+		c.Types[k] = v
+	}
+	for k, v := range l.GraphNodes {
+		c.GraphNodes[k] = v
+	}
+	for k, v := range l.Params {
+		c.Params[k] = v
+	}
+	return c
+}
+
+// paramDesignation returns the designation of the parameter
+// used as its key in ID locks
+func paramDesignation(param *Parameter) string {
+	return param.Target.GraphNodeName() + "." + param.Name
+}
+
+// pinnedType returns the identifier pinned to the given type name
+func (l *IDLock) pinnedType(name string) (TypeID, bool) {
+	if l == nil {
+		return 0, false
+	}
+	id, isPinned := l.Types[name]
+	return id, isPinned
+}
+
+// pinnedGraphNode returns the identifier pinned to the given graph node name
+func (l *IDLock) pinnedGraphNode(name string) (GraphNodeID, bool) {
+	if l == nil {
+		return 0, false
+	}
+	id, isPinned := l.GraphNodes[name]
+	return id, isPinned
+}
+
+// pinnedParam returns the identifier pinned to the given parameter
+func (l *IDLock) pinnedParam(designation string) (ParamID, bool) {
+	if l == nil {
+		return 0, false
+	}
+	id, isPinned := l.Params[designation]
+	return id, isPinned
+}
@@ -2,11 +2,14 @@ package parser
 
 // Query represents a query endpoint
 type Query struct {
-	Src        Fragment
-	Name       string
-	GraphID    GraphNodeID
-	Parameters []*Parameter
-	Type       Type
+	Src         Fragment
+	Name        string
+	GraphID     GraphNodeID
+	Parameters  []*Parameter
+	Type        Type
+	Doc         string
+	Deprecated  *Deprecation
+	Annotations []*Annotation
 }
 
 // Source returns the source location of the declaration
@@ -2,12 +2,15 @@ package parser
 
 // ResolverProperty represents a resolver property
 type ResolverProperty struct {
-	Src        Fragment
-	Resolver   *TypeResolver
-	Name       string
-	GraphID    GraphNodeID
-	Type       Type
-	Parameters []*Parameter
+	Src         Fragment
+	Resolver    *TypeResolver
+	Name        string
+	GraphID     GraphNodeID
+	Type        Type
+	Parameters  []*Parameter
+	Doc         string
+	Deprecated  *Deprecation
+	Annotations []*Annotation
 }
 
 // Source returns the source location of the declaration
@@ -2,11 +2,15 @@ package parser
 
 // StructField represents a struct field
 type StructField struct {
-	Src     Fragment
-	Struct  *TypeStruct
-	GraphID GraphNodeID
-	Name    string
-	Type    Type
+	Src         Fragment
+	Struct      *TypeStruct
+	GraphID     GraphNodeID
+	Name        string
+	Type        Type
+	Doc         string
+	Deprecated  *Deprecation
+	Annotations []*Annotation
+	Constraints []*Constraint
 }
 
 // Source returns the source location of the declaration
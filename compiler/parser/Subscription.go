@@ -0,0 +1,29 @@
+package parser
+
+// Subscription represents a subscription endpoint
+type Subscription struct {
+	Src         Fragment
+	Name        string
+	GraphID     GraphNodeID
+	Parameters  []*Parameter
+	Type        Type
+	Doc         string
+	Deprecated  *Deprecation
+	Annotations []*Annotation
+}
+
+// Source returns the source location of the declaration
+func (sb *Subscription) Source() Fragment { return sb.Src }
+
+// GraphNodeID returns the subscription endpoint's unique graph node
+// identifier
+func (sb *Subscription) GraphNodeID() GraphNodeID { return sb.GraphID }
+
+// NodeName returns the subscription endpoint's name
+func (sb *Subscription) NodeName() string { return sb.Name }
+
+// GraphNodeName returns the subscription endpoint's graph node name
+func (sb *Subscription) GraphNodeName() string { return sb.Name }
+
+// Parent returns nil indicating root
+func (sb *Subscription) Parent() Type { return nil }
@@ -0,0 +1,36 @@
+package parser
+
+// TraitProperty represents a trait property
+type TraitProperty struct {
+	Src         Fragment
+	Trait       *TypeTrait
+	Name        string
+	GraphID     GraphNodeID
+	Type        Type
+	Parameters  []*Parameter
+	Doc         string
+	Deprecated  *Deprecation
+	Annotations []*Annotation
+}
+
+// Source returns the source location of the declaration
+func (tp *TraitProperty) Source() Fragment { return tp.Src }
+
+// GraphNodeID returns the unique graph node identifier of the trait prop
+func (tp *TraitProperty) GraphNodeID() GraphNodeID { return tp.GraphID }
+
+// Parent returns the parent trait type of the trait prop
+func (tp *TraitProperty) Parent() Type { return tp.Trait }
+
+// NodeName returns the property name
+func (tp *TraitProperty) NodeName() string { return tp.Name }
+
+// GraphNodeName returns the graph node name
+func (tp *TraitProperty) GraphNodeName() string {
+	return tp.Trait.String() + "." + tp.Name
+}
+
+// String returns the property designation
+func (tp *TraitProperty) String() string {
+	return tp.GraphNodeName() + "(" + tp.Type.String() + ")"
+}
@@ -44,6 +44,42 @@ const (
 	// RFC3339 encoded time type
 	TypeIDPrimitiveTime TypeID = 10
 
+	// TypeIDPrimitiveInt8 represents the unique identifier of the primitive
+	// 8-bit signed integer type
+	TypeIDPrimitiveInt8 TypeID = 11
+
+	// TypeIDPrimitiveInt16 represents the unique identifier of the primitive
+	// 16-bit signed integer type
+	TypeIDPrimitiveInt16 TypeID = 12
+
+	// TypeIDPrimitiveUint8 represents the unique identifier of the primitive
+	// 8-bit unsigned integer type
+	TypeIDPrimitiveUint8 TypeID = 13
+
+	// TypeIDPrimitiveUint16 represents the unique identifier of the primitive
+	// 16-bit unsigned integer type
+	TypeIDPrimitiveUint16 TypeID = 14
+
+	// TypeIDPrimitiveFloat32 represents the unique identifier of the primitive
+	// 32-bit floating point number type
+	TypeIDPrimitiveFloat32 TypeID = 15
+
+	// TypeIDPrimitiveDuration represents the unique identifier of the primitive
+	// time duration type
+	TypeIDPrimitiveDuration TypeID = 16
+
+	// TypeIDPrimitiveDate represents the unique identifier of the primitive
+	// calendar date type (RFC3339 full-date)
+	TypeIDPrimitiveDate TypeID = 17
+
+	// TypeIDPrimitiveUUID represents the unique identifier of the primitive
+	// RFC4122 universally unique identifier type
+	TypeIDPrimitiveUUID TypeID = 18
+
+	// TypeIDPrimitiveDecimal represents the unique identifier of the primitive
+	// fixed-point decimal number type
+	TypeIDPrimitiveDecimal TypeID = 19
+
 	// TypeIDUserTypeOffset represents the ID offset for user-defined types
 	// (the ID of the first user-defined type starts with 100)
 	TypeIDUserTypeOffset TypeID = 99
@@ -0,0 +1,234 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ValueKind represents the kind of a literal value
+type ValueKind int
+
+const (
+	_ ValueKind = iota
+
+	// ValueKindNull represents the null literal
+	ValueKindNull
+
+	// ValueKindBool represents a boolean literal
+	ValueKindBool
+
+	// ValueKindNumber represents a number literal
+	ValueKindNumber
+
+	// ValueKindString represents a string literal
+	ValueKindString
+
+	// ValueKindEnum represents an enum value literal
+	ValueKindEnum
+
+	// ValueKindList represents a list literal
+	ValueKindList
+
+	// ValueKindStruct represents a struct literal
+	ValueKindStruct
+)
+
+// String stringifies the value kind
+func (k ValueKind) String() string {
+	switch k {
+	case ValueKindNull:
+		return "null"
+	case ValueKindBool:
+		return "boolean"
+	case ValueKindNumber:
+		return "number"
+	case ValueKindString:
+		return "string"
+	case ValueKindEnum:
+		return "enum value"
+	case ValueKindList:
+		return "list"
+	case ValueKindStruct:
+		return "struct"
+	}
+	return ""
+}
+
+// Value represents a literal value
+type Value struct {
+	Src  Fragment
+	Kind ValueKind
+
+	// Literal is the source literal of null, boolean, number and enum values
+	// and the unquoted contents of string values
+	Literal string
+
+	// Items are the items of a list literal
+	Items []*Value
+
+	// Fields are the fields of a struct literal in order of declaration
+	Fields []*ValueField
+}
+
+// ValueField represents a field of a struct literal
+type ValueField struct {
+	Src   Fragment
+	Name  string
+	Value *Value
+}
+
+// Field returns the struct literal field by name or nil if there's none
+func (v *Value) Field(name string) *ValueField {
+	for _, fld := range v.Fields {
+		if fld.Name == name {
+			return fld
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes the value to JSON.
+// Enum values are encoded as strings and struct literals
+// as objects preserving the order of their fields
+func (v *Value) MarshalJSON() ([]byte, error) {
+	switch v.Kind {
+	case ValueKindNull:
+		return []byte("null"), nil
+	case ValueKindBool:
+		return []byte(v.Literal), nil
+	case ValueKindNumber:
+		return []byte(normalizeNumLiteral(v.Literal)), nil
+	case ValueKindString, ValueKindEnum:
+		return json.Marshal(v.Literal)
+	case ValueKindList:
+		buf := bytes.Buffer{}
+		buf.WriteByte('[')
+		for i, item := range v.Items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			encoded, err := item.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	case ValueKindStruct:
+		buf := bytes.Buffer{}
+		buf.WriteByte('{')
+		for i, fld := range v.Fields {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			name, err := json.Marshal(fld.Name)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(name)
+			buf.WriteByte(':')
+			encoded, err := fld.Value.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes the value from JSON preserving the order of
+// struct literal fields. Since JSON doesn't distinguish enum values
+// from strings they're decoded as string literals
+func (v *Value) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	decoded, err := decodeValue(dec)
+	if err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("unexpected data after value")
+	}
+	*v = *decoded
+	return nil
+}
+
+// decodeValue decodes the next value from the decoder
+func decodeValue(dec *json.Decoder) (*Value, error) {
+	tk, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tk.(type) {
+	case nil:
+		return &Value{Kind: ValueKindNull, Literal: "null"}, nil
+	case bool:
+		return &Value{
+			Kind:    ValueKindBool,
+			Literal: strconv.FormatBool(t),
+		}, nil
+	case json.Number:
+		return &Value{Kind: ValueKindNumber, Literal: t.String()}, nil
+	case string:
+		return &Value{Kind: ValueKindString, Literal: t}, nil
+	case json.Delim:
+		if t == '[' {
+			v := &Value{Kind: ValueKindList, Items: []*Value{}}
+			for dec.More() {
+				item, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				v.Items = append(v.Items, item)
+			}
+			// Consume the closing bracket
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return v, nil
+		}
+
+		v := &Value{Kind: ValueKindStruct, Fields: []*ValueField{}}
+		for dec.More() {
+			name, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			val, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			v.Fields = append(v.Fields, &ValueField{
+				Name:  name.(string),
+				Value: val,
+			})
+		}
+		// Consume the closing brace
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unexpected JSON token: %v", tk)
+}
+
+// normalizeNumLiteral returns the JSON representation of a number literal
+// or the literal itself if it's not a number
+func normalizeNumLiteral(literal string) string {
+	if i, err := strconv.ParseInt(literal, 10, 64); err == nil {
+		return strconv.FormatInt(i, 10)
+	}
+	if u, err := strconv.ParseUint(literal, 10, 64); err == nil {
+		return strconv.FormatUint(u, 10)
+	}
+	if f, err := strconv.ParseFloat(literal, 64); err == nil {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return literal
+}
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+)
+
+// applyIDLock checks the ID lock for conflicts and makes sure
+// newly issued identifiers don't collide with pinned ones
+func (pr *Parser) applyIDLock() {
+	if pr.idLock == nil {
+		return
+	}
+
+	conflict := func(format string, v ...interface{}) {
+		pr.err(&pErr{
+			code:    ErrIDLockConflict,
+			message: fmt.Sprintf(format, v...),
+		})
+	}
+
+	// Check the pinned identifiers in order of designation
+	// to report conflicts deterministically
+	sorted := func(keys []string) []string {
+		sort.Strings(keys)
+		return keys
+	}
+
+	typeNames := make([]string, 0, len(pr.idLock.Types))
+	for name := range pr.idLock.Types {
+		typeNames = append(typeNames, name)
+	}
+	typeByID := map[TypeID]string{}
+	for _, name := range sorted(typeNames) {
+		id := pr.idLock.Types[name]
+		if id <= TypeIDUserTypeOffset {
+			conflict(
+				"ID lock: type %s pinned to reserved ID %d",
+				name,
+				id,
+			)
+			continue
+		}
+		if other, isTaken := typeByID[id]; isTaken {
+			conflict(
+				"ID lock: types %s and %s pinned to the same ID %d",
+				other,
+				name,
+				id,
+			)
+			continue
+		}
+		typeByID[id] = name
+		if id > pr.lastIssuedTypeID {
+			pr.lastIssuedTypeID = id
+		}
+	}
+
+	nodeNames := make([]string, 0, len(pr.idLock.GraphNodes))
+	for name := range pr.idLock.GraphNodes {
+		nodeNames = append(nodeNames, name)
+	}
+	nodeByID := map[GraphNodeID]string{}
+	for _, name := range sorted(nodeNames) {
+		id := pr.idLock.GraphNodes[name]
+		if id < 1 {
+			conflict(
+				"ID lock: graph node %s pinned to illegal ID %d",
+				name,
+				id,
+			)
+			continue
+		}
+		if other, isTaken := nodeByID[id]; isTaken {
+			conflict(
+				"ID lock: graph nodes %s and %s pinned to the same ID %d",
+				other,
+				name,
+				id,
+			)
+			continue
+		}
+		nodeByID[id] = name
+		if id > pr.lastIssuedGraphID {
+			pr.lastIssuedGraphID = id
+		}
+	}
+
+	paramNames := make([]string, 0, len(pr.idLock.Params))
+	for name := range pr.idLock.Params {
+		paramNames = append(paramNames, name)
+	}
+	paramByID := map[ParamID]string{}
+	for _, name := range sorted(paramNames) {
+		id := pr.idLock.Params[name]
+		if id < 1 {
+			conflict(
+				"ID lock: parameter %s pinned to illegal ID %d",
+				name,
+				id,
+			)
+			continue
+		}
+		if other, isTaken := paramByID[id]; isTaken {
+			conflict(
+				"ID lock: parameters %s and %s pinned to the same ID %d",
+				other,
+				name,
+				id,
+			)
+			continue
+		}
+		paramByID[id] = name
+		if id > pr.lastIssuedParamID {
+			pr.lastIssuedParamID = id
+		}
+	}
+}
+
+// issueTypeID issues a unique identifier for the type of the given name.
+// Identifiers pinned by the ID lock are reused
+func (pr *Parser) issueTypeID(name string) TypeID {
+	if id, isPinned := pr.idLock.pinnedType(name); isPinned {
+		return id
+	}
+	pr.lastIssuedTypeID += TypeID(1)
+	return pr.lastIssuedTypeID
+}
+
+// issueGraphNodeID issues a unique identifier for the graph node
+// of the given name. Identifiers pinned by the ID lock are reused
+func (pr *Parser) issueGraphNodeID(name string) GraphNodeID {
+	if id, isPinned := pr.idLock.pinnedGraphNode(name); isPinned {
+		return id
+	}
+	pr.lastIssuedGraphID += GraphNodeID(1)
+	return pr.lastIssuedGraphID
+}
+
+// issueParamID issues a unique identifier for the given parameter.
+// Identifiers pinned by the ID lock are reused
+func (pr *Parser) issueParamID(param *Parameter) ParamID {
+	id, isPinned := pr.idLock.pinnedParam(paramDesignation(param))
+	if isPinned {
+		return id
+	}
+	pr.lastIssuedParamID += ParamID(1)
+	return pr.lastIssuedParamID
+}
@@ -0,0 +1,24 @@
+package parser
+
+import "fmt"
+
+// checkAliasChains warns about every alias type aliasing another alias
+func (pr *Parser) checkAliasChains() {
+	for _, t := range pr.mod.AliasTypes {
+		alias := t.(*TypeAlias)
+		aliased, isAlias := alias.AliasedType.(*TypeAlias)
+		if !isAlias {
+			continue
+		}
+		pr.warn(&pErr{
+			at:   alias.Src.Begin(),
+			code: ErrAliasChain,
+			message: fmt.Sprintf(
+				"alias %s aliases alias %s (consider aliasing %s directly)",
+				alias,
+				aliased,
+				unaliased(aliased),
+			),
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package parser
+
+import "fmt"
+
+// checkAnnotations checks the annotations of a declaration against
+// the registered annotation schemas returning false if any of them
+// is either unknown or malformed. Annotations aren't checked
+// if no annotation schemas were registered
+func (pr *Parser) checkAnnotations(annotations []*Annotation) bool {
+	if len(pr.annotationSchemas) < 1 {
+		return true
+	}
+
+	valid := true
+	illegal := func(at Cursor, format string, v ...interface{}) {
+		valid = false
+		pr.err(&pErr{
+			at:      at,
+			code:    ErrAnnotationIllegal,
+			message: fmt.Sprintf(format, v...),
+		})
+	}
+
+	applied := map[string]bool{}
+	for _, annotation := range annotations {
+		schema, isKnown := pr.annotationSchemas[annotation.Name]
+		if !isKnown {
+			illegal(
+				annotation.Src.Begin(),
+				"unknown annotation @%s",
+				annotation.Name,
+			)
+			continue
+		}
+
+		if applied[annotation.Name] && !schema.Repeatable {
+			illegal(
+				annotation.Src.Begin(),
+				"non-repeatable annotation @%s applied more than once",
+				annotation.Name,
+			)
+			continue
+		}
+		applied[annotation.Name] = true
+
+		// Check the number of arguments
+		required := 0
+		for _, param := range schema.Params {
+			if !param.Optional {
+				required++
+			}
+		}
+		if len(annotation.Args) < required ||
+			len(annotation.Args) > len(schema.Params) {
+			expected := fmt.Sprintf("%d", required)
+			if required < len(schema.Params) {
+				expected = fmt.Sprintf(
+					"%d to %d",
+					required,
+					len(schema.Params),
+				)
+			}
+			illegal(
+				annotation.Src.Begin(),
+				"annotation @%s expects %s arguments, got %d",
+				annotation.Name,
+				expected,
+				len(annotation.Args),
+			)
+			continue
+		}
+
+		// Check the types of the arguments
+		for i, arg := range annotation.Args {
+			param := schema.Params[i]
+			if mismatch := matchValue(arg, param.Type); mismatch != "" {
+				illegal(
+					arg.Src.Begin(),
+					"mismatching argument %s of annotation @%s (%s)",
+					param.Name,
+					annotation.Name,
+					mismatch,
+				)
+			}
+		}
+	}
+	return valid
+}
@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// checkConstraints checks whether the given constraints are applicable
+// to the given type and well-formed. Constraints of optional types
+// apply to the stored type, constraints of scalar types to the base type
+func (pr *Parser) checkConstraints(constraints []*Constraint, t Type) {
+	t = unaliased(t)
+	if opt, isOptional := t.(*TypeOptional); isOptional {
+		t = unaliased(opt.StoreType)
+	}
+	if scalar, isScalar := t.(*TypeScalar); isScalar {
+		t = unaliased(scalar.BaseType)
+	}
+	if t == nil {
+		// Unresolved types are reported elsewhere
+		return
+	}
+
+	numeric, lengthy := false, false
+	switch t.(type) {
+	case TypeStdByte,
+		TypeStdInt8,
+		TypeStdInt16,
+		TypeStdInt32,
+		TypeStdInt64,
+		TypeStdUint8,
+		TypeStdUint16,
+		TypeStdUint32,
+		TypeStdUint64,
+		TypeStdFloat32,
+		TypeStdFloat64,
+		TypeStdDecimal:
+		numeric = true
+	case TypeStdString, *TypeList, *TypeMap:
+		lengthy = true
+	}
+
+	illegal := func(c *Constraint, format string, v ...interface{}) {
+		pr.err(&pErr{
+			at:      c.Src.Begin(),
+			code:    ErrConstraintIllegal,
+			message: fmt.Sprintf(format, v...),
+		})
+	}
+
+	const notApplicable = "constraint %s isn't applicable to type %s"
+
+	byKind := map[ConstraintKind]*Constraint{}
+	for _, c := range constraints {
+		if defined, isDefined := byKind[c.Kind]; isDefined {
+			illegal(
+				c,
+				"redeclaration of constraint %s "+
+					"(previously declared at %s)",
+				c.Kind,
+				defined.Src.Begin(),
+			)
+			continue
+		}
+		byKind[c.Kind] = c
+
+		switch c.Kind {
+		case ConstraintMin, ConstraintMax:
+			if !numeric {
+				illegal(c, notApplicable, c.Kind, t)
+				continue
+			}
+			if mismatch := matchValue(c.Value, t); mismatch != "" {
+				illegal(c, "illegal %s constraint (%s)", c.Kind, mismatch)
+			}
+
+		case ConstraintMinLength, ConstraintMaxLength:
+			if !lengthy {
+				illegal(c, notApplicable, c.Kind, t)
+				continue
+			}
+			mismatch := matchValue(c.Value, TypeStdUint64{})
+			if mismatch != "" {
+				illegal(c, "illegal %s constraint (%s)", c.Kind, mismatch)
+			}
+
+		case ConstraintPattern:
+			if _, isString := t.(TypeStdString); !isString {
+				illegal(c, notApplicable, c.Kind, t)
+				continue
+			}
+			if c.Value.Kind != ValueKindString {
+				illegal(c, "illegal pattern constraint (expected string)")
+				continue
+			}
+			if _, err := regexp.Compile(c.Value.Literal); err != nil {
+				illegal(c, "illegal pattern constraint (%s)", err)
+			}
+
+		case ConstraintNonEmpty:
+			if !lengthy {
+				illegal(c, notApplicable, c.Kind, t)
+			}
+		}
+	}
+
+	// Make sure lower bounds don't exceed upper bounds
+	checkBounds := func(lower, upper ConstraintKind) {
+		min, max := byKind[lower], byKind[upper]
+		if min == nil || max == nil {
+			return
+		}
+		minVal, errMin := strconv.ParseFloat(min.Value.Literal, 64)
+		maxVal, errMax := strconv.ParseFloat(max.Value.Literal, 64)
+		if errMin != nil || errMax != nil {
+			// Illegal bounds are reported above
+			return
+		}
+		if minVal > maxVal {
+			illegal(
+				max,
+				"constraint %s(%s) is lower than %s(%s)",
+				upper,
+				max.Value.Literal,
+				lower,
+				min.Value.Literal,
+			)
+		}
+	}
+	checkBounds(ConstraintMin, ConstraintMax)
+	checkBounds(ConstraintMinLength, ConstraintMaxLength)
+}
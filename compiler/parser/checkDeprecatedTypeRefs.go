@@ -0,0 +1,83 @@
+package parser
+
+import "fmt"
+
+// checkDeprecatedTypeRefs warns about every deprecated type referenced
+// by the signature of a non-deprecated query, mutation
+// or subscription endpoint
+func (pr *Parser) checkDeprecatedTypeRefs() {
+	check := func(
+		endpoint GraphNode,
+		deprecated *Deprecation,
+		params []*Parameter,
+		result Type,
+	) {
+		if deprecated != nil {
+			return
+		}
+		reported := map[Type]bool{}
+		report := func(t Type) {
+			for _, ref := range deprecatedTypeRefs(t) {
+				if reported[ref] {
+					continue
+				}
+				reported[ref] = true
+				pr.warn(&pErr{
+					at:   endpoint.Source().Begin(),
+					code: ErrDeprecatedTypeRef,
+					message: fmt.Sprintf(
+						"endpoint %s references deprecated type %s",
+						endpoint.GraphNodeName(),
+						ref,
+					),
+				})
+			}
+		}
+		for _, param := range params {
+			report(param.Type)
+		}
+		report(result)
+	}
+
+	for _, qry := range pr.mod.QueryEndpoints {
+		check(qry, qry.Deprecated, qry.Parameters, qry.Type)
+	}
+	for _, mut := range pr.mod.Mutations {
+		check(mut, mut.Deprecated, mut.Parameters, mut.Type)
+	}
+	for _, sub := range pr.mod.Subscriptions {
+		check(sub, sub.Deprecated, sub.Parameters, sub.Type)
+	}
+}
+
+// deprecatedTypeRefs returns all deprecated types referenced by the given
+// type designation including the store and key types of containers
+// and the types aliased by referenced aliases
+func deprecatedTypeRefs(t Type) (refs []Type) {
+	visited := map[Type]bool{}
+	var walk func(t Type)
+	walk = func(t Type) {
+		if t == nil || visited[t] {
+			return
+		}
+		visited[t] = true
+		if declared, isDeclared := t.(interface {
+			deprecation() *Deprecation
+		}); isDeclared && declared.deprecation() != nil {
+			refs = append(refs, t)
+		}
+		switch v := t.(type) {
+		case *TypeOptional:
+			walk(v.StoreType)
+		case *TypeList:
+			walk(v.StoreType)
+		case *TypeMap:
+			walk(v.KeyType)
+			walk(v.StoreType)
+		case *TypeAlias:
+			walk(v.AliasedType)
+		}
+	}
+	walk(t)
+	return
+}
@@ -0,0 +1,30 @@
+package parser
+
+import "fmt"
+
+// checkNoneUnions warns about every union type of which all option types
+// are aliases of None since such unions are better represented by enums
+func (pr *Parser) checkNoneUnions() {
+	for _, t := range pr.mod.UnionTypes {
+		union := t.(*TypeUnion)
+		noneOnly := len(union.Types) > 0
+		for _, option := range union.Types {
+			if _, isNone := unaliased(option).(TypeStdNone); !isNone {
+				noneOnly = false
+				break
+			}
+		}
+		if !noneOnly {
+			continue
+		}
+		pr.warn(&pErr{
+			at:   union.Src.Begin(),
+			code: ErrUnionNoneOpts,
+			message: fmt.Sprintf(
+				"all option types of union %s are aliases of None "+
+					"(consider using an enum instead)",
+				union,
+			),
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package parser
+
+import "fmt"
+
+// checkSingleValEnums warns about every enum type of a single value
+func (pr *Parser) checkSingleValEnums() {
+	for _, t := range pr.mod.EnumTypes {
+		enum := t.(*TypeEnum)
+		if len(enum.Values) != 1 {
+			continue
+		}
+		pr.warn(&pErr{
+			at:   enum.Src.Begin(),
+			code: ErrEnumSingleVal,
+			message: fmt.Sprintf(
+				"enum %s has only a single value (%s)",
+				enum,
+				enum.Values[0].Name,
+			),
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package parser
+
+import "fmt"
+
+// checkTraitImpl makes sure the implementation provides all properties
+// of the given trait with an identical type and parameter list
+func (pr *Parser) checkTraitImpl(
+	at *Token,
+	implementation Type,
+	trait *TypeTrait,
+) {
+	for _, traitProp := range trait.Properties {
+		var propType Type
+		var params []*Parameter
+		found := false
+
+		switch t := implementation.(type) {
+		case *TypeResolver:
+			for _, prop := range t.Properties {
+				if prop.Name == traitProp.Name {
+					propType = prop.Type
+					params = prop.Parameters
+					found = true
+					break
+				}
+			}
+		case *TypeStruct:
+			for _, fld := range t.Fields {
+				if fld.Name == traitProp.Name {
+					propType = fld.Type
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			pr.err(&pErr{
+				at:   at.begin,
+				code: ErrTraitImplMissingProp,
+				message: fmt.Sprintf(
+					"%s is missing property %s of trait %s",
+					implementation,
+					traitProp.Name,
+					trait,
+				),
+			})
+			continue
+		}
+
+		if propType == nil || traitProp.Type == nil {
+			// Undefined types are reported elsewhere
+			continue
+		}
+
+		if !sameType(propType, traitProp.Type) ||
+			!sameParams(params, traitProp.Parameters) {
+			pr.err(&pErr{
+				at:   at.begin,
+				code: ErrTraitImplPropMismatch,
+				message: fmt.Sprintf(
+					"property %s of %s doesn't match "+
+						"the signature of %s",
+					traitProp.Name,
+					implementation,
+					traitProp.GraphNodeName(),
+				),
+			})
+		}
+	}
+}
+
+// sameType returns true if a and b are the same type,
+// otherwise returns false
+func sameType(a, b Type) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.TypeID() == b.TypeID()
+}
+
+// sameParams returns true if both parameter lists are identical
+// in names, order and types, otherwise returns false
+func sameParams(a, b []*Parameter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, p := range a {
+		if p.Name != b[i].Name || !sameType(p.Type, b[i].Type) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package parser
+
+import "fmt"
+
+// checkUnusedTypes warns about every user type that's not reachable
+// from any query, mutation or subscription endpoint
+func (pr *Parser) checkUnusedTypes() {
+	used := map[Type]bool{}
+	var use func(t Type)
+	useParams := func(params []*Parameter) {
+		for _, param := range params {
+			use(param.Type)
+		}
+	}
+	use = func(t Type) {
+		if t == nil || used[t] {
+			return
+		}
+		used[t] = true
+		switch v := t.(type) {
+		case *TypeOptional:
+			use(v.StoreType)
+		case *TypeList:
+			use(v.StoreType)
+		case *TypeMap:
+			use(v.KeyType)
+			use(v.StoreType)
+		case *TypeAlias:
+			use(v.AliasedType)
+		case *TypeScalar:
+			use(v.BaseType)
+		case *TypeUnion:
+			for _, option := range v.Types {
+				use(option)
+			}
+		case *TypeStruct:
+			for _, fld := range v.Fields {
+				use(fld.Type)
+			}
+			for _, trait := range v.Implements {
+				use(trait)
+			}
+		case *TypeResolver:
+			for _, prop := range v.Properties {
+				use(prop.Type)
+				useParams(prop.Parameters)
+			}
+			for _, trait := range v.Implements {
+				use(trait)
+			}
+		case *TypeTrait:
+			// Any implementation could be returned in place of the trait
+			for _, prop := range v.Properties {
+				use(prop.Type)
+				useParams(prop.Parameters)
+			}
+			for _, impl := range v.Implementations {
+				use(impl)
+			}
+		}
+	}
+
+	for _, qry := range pr.mod.QueryEndpoints {
+		useParams(qry.Parameters)
+		use(qry.Type)
+	}
+	for _, mut := range pr.mod.Mutations {
+		useParams(mut.Parameters)
+		use(mut.Type)
+	}
+	for _, sub := range pr.mod.Subscriptions {
+		useParams(sub.Parameters)
+		use(sub.Type)
+	}
+
+	for _, t := range pr.mod.Types {
+		if used[t] || t.TerminalType() != nil {
+			// Used or anonymous
+			continue
+		}
+		pr.warn(&pErr{
+			at:   t.Source().Begin(),
+			code: ErrTypeUnused,
+			message: fmt.Sprintf(
+				"type %s isn't reachable from any endpoint",
+				t,
+			),
+		})
+	}
+}
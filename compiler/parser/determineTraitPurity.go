@@ -0,0 +1,30 @@
+package parser
+
+// determineTraitPurity determines the purity of all declared trait types.
+// A trait is pure if all of its properties are parameterless and of pure
+// types. Traits referencing each other are assumed pure until proven
+// otherwise
+func (pr *Parser) determineTraitPurity() {
+	for _, t := range pr.mod.TraitTypes {
+		t.(*TypeTrait).Pure = true
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, t := range pr.mod.TraitTypes {
+			trait := t.(*TypeTrait)
+			if !trait.Pure {
+				continue
+			}
+			for _, prop := range trait.Properties {
+				if len(prop.Parameters) > 0 ||
+					prop.Type == nil ||
+					!prop.Type.IsPure() {
+					trait.Pure = false
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
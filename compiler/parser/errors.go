@@ -65,6 +65,26 @@ const (
 	// ErrResolverNoProps indicates an empty resolver type missing properties
 	ErrResolverNoProps
 
+	// ErrTraitPropRedecl indicates a redeclared trait property
+	ErrTraitPropRedecl
+
+	// ErrTraitNoProps indicates an empty trait type missing properties
+	ErrTraitNoProps
+
+	// ErrTraitImplNonTrait indicates an implementation of a non-trait type
+	ErrTraitImplNonTrait
+
+	// ErrTraitImplRedund indicates a redundantly implemented trait
+	ErrTraitImplRedund
+
+	// ErrTraitImplMissingProp indicates an incomplete trait implementation
+	// missing a trait property
+	ErrTraitImplMissingProp
+
+	// ErrTraitImplPropMismatch indicates a trait implementation declaring
+	// a property with a type or parameter list different from the trait's
+	ErrTraitImplPropMismatch
+
 	// ErrParamImpure indicates a parameter of a non-data (impure) type
 	ErrParamImpure
 
@@ -73,8 +93,102 @@ const (
 
 	// ErrNoEndpoints indicates the absence of any API endpoints
 	ErrNoEndpoints
+
+	// ErrImportLoad indicates a failure to load an imported source file
+	ErrImportLoad
+
+	// ErrImportCycle indicates a cyclic import
+	ErrImportCycle
+
+	// ErrImportSchemaMismatch indicates an imported source file declaring
+	// a schema name different from the one of the importing file
+	ErrImportSchemaMismatch
+
+	// ErrTypeParamRedecl indicates a redeclared type parameter
+	// or a type parameter colliding with a type
+	ErrTypeParamRedecl
+
+	// ErrTypeNotGeneric indicates type arguments passed to a non-generic type
+	ErrTypeNotGeneric
+
+	// ErrGenericArgCount indicates a generic type instantiated with
+	// a wrong number of type arguments
+	ErrGenericArgCount
+
+	// ErrGenericMissingArgs indicates a generic type referenced
+	// without type arguments
+	ErrGenericMissingArgs
+
+	// ErrGenericRecurs indicates an infinitely recursive instantiation
+	// of a generic type
+	ErrGenericRecurs
+
+	// ErrMapKeyIllegal indicates a map type of an illegal key type
+	ErrMapKeyIllegal
+
+	// ErrParamDefaultMismatch indicates a parameter default value
+	// that doesn't match the type of the parameter
+	ErrParamDefaultMismatch
+
+	// ErrDeprecatedTypeRef indicates a reference to a deprecated type
+	// in the signature of a non-deprecated endpoint.
+	// It's reported as a warning
+	ErrDeprecatedTypeRef
+
+	// ErrAnnotationIllegal indicates either an unknown annotation
+	// or an annotation not matching its registered schema
+	ErrAnnotationIllegal
+
+	// ErrConstraintIllegal indicates a value constraint that's either
+	// inapplicable to the constrained type or malformed
+	ErrConstraintIllegal
+
+	// ErrScalarBaseIllegal indicates a scalar type of an illegal base type
+	ErrScalarBaseIllegal
+
+	// ErrTypeUnused indicates a user type that's not reachable
+	// from any endpoint. It's reported as a warning
+	ErrTypeUnused
+
+	// ErrAliasChain indicates an alias of another alias.
+	// It's reported as a warning
+	ErrAliasChain
+
+	// ErrEnumSingleVal indicates an enum type of a single value.
+	// It's reported as a warning
+	ErrEnumSingleVal
+
+	// ErrUnionNoneOpts indicates a union type of which all option types
+	// are aliases of None. It's reported as a warning
+	ErrUnionNoneOpts
+
+	// ErrIDLockConflict indicates conflicting identifiers in the ID lock
+	ErrIDLockConflict
+)
+
+// Severity represents the severity level of a compiler diagnostic
+type Severity int
+
+const (
+	// SeverityError indicates an error causing compilation to fail
+	SeverityError Severity = iota
+
+	// SeverityWarning indicates a warning that doesn't cause
+	// compilation to fail
+	SeverityWarning
 )
 
+// String stringifies the severity level
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return ""
+}
+
 // String stringifies the error code
 func (c ErrCode) String() string {
 	switch c {
@@ -114,12 +228,62 @@ func (c ErrCode) String() string {
 		return "ResolverPropRedecl"
 	case ErrResolverNoProps:
 		return "ResolverNoProps"
+	case ErrTraitPropRedecl:
+		return "TraitPropRedecl"
+	case ErrTraitNoProps:
+		return "TraitNoProps"
+	case ErrTraitImplNonTrait:
+		return "TraitImplNonTrait"
+	case ErrTraitImplRedund:
+		return "TraitImplRedund"
+	case ErrTraitImplMissingProp:
+		return "TraitImplMissingProp"
+	case ErrTraitImplPropMismatch:
+		return "TraitImplPropMismatch"
 	case ErrParamImpure:
 		return "ParamImpure"
 	case ErrParamRedecl:
 		return "ParamRedecl"
 	case ErrNoEndpoints:
 		return "NoEndpoints"
+	case ErrImportLoad:
+		return "ImportLoad"
+	case ErrImportCycle:
+		return "ImportCycle"
+	case ErrImportSchemaMismatch:
+		return "ImportSchemaMismatch"
+	case ErrTypeParamRedecl:
+		return "TypeParamRedecl"
+	case ErrTypeNotGeneric:
+		return "TypeNotGeneric"
+	case ErrGenericArgCount:
+		return "GenericArgCount"
+	case ErrGenericMissingArgs:
+		return "GenericMissingArgs"
+	case ErrGenericRecurs:
+		return "GenericRecurs"
+	case ErrMapKeyIllegal:
+		return "MapKeyIllegal"
+	case ErrParamDefaultMismatch:
+		return "ParamDefaultMismatch"
+	case ErrDeprecatedTypeRef:
+		return "DeprecatedTypeRef"
+	case ErrAnnotationIllegal:
+		return "AnnotationIllegal"
+	case ErrConstraintIllegal:
+		return "ConstraintIllegal"
+	case ErrScalarBaseIllegal:
+		return "ScalarBaseIllegal"
+	case ErrTypeUnused:
+		return "TypeUnused"
+	case ErrAliasChain:
+		return "AliasChain"
+	case ErrEnumSingleVal:
+		return "EnumSingleVal"
+	case ErrUnionNoneOpts:
+		return "UnionNoneOpts"
+	case ErrIDLockConflict:
+		return "IDLockConflict"
 	}
 	return ""
 }
@@ -136,13 +300,17 @@ type Error interface {
 
 	// At returns the error position in the source code
 	At() Cursor
+
+	// Severity returns the severity level of the diagnostic
+	Severity() Severity
 }
 
 // pErr represents a syntax error
 type pErr struct {
-	code    ErrCode
-	message string
-	at      Cursor
+	code     ErrCode
+	message  string
+	at       Cursor
+	severity Severity
 }
 
 func (err *pErr) Error() string {
@@ -163,6 +331,9 @@ func (err *pErr) Message() string { return err.message }
 // At returns the error position in the source code
 func (err *pErr) At() Cursor { return err.at }
 
+// Severity returns the severity level of the diagnostic
+func (err *pErr) Severity() Severity { return err.severity }
+
 // ParseErr represents a parsing error
 type ParseErr struct {
 	Errors []Error
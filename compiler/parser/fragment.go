@@ -25,6 +25,7 @@ func (crs Cursor) String() string {
 
 // Fragment represents a typed source code fragment
 type Fragment interface {
+	FragID() FragID
 	Begin() Cursor
 	End() Cursor
 	Src() string
@@ -43,6 +44,10 @@ const (
 	// (any combinations of spaces, tabs & line-breaks)
 	FragTkSpace
 
+	// FragTkComment represents a non-documentation comment token
+	// (either a '//' line comment or a '/* */' block comment)
+	FragTkComment
+
 	// FragTkBlk represents a '{' token
 	FragTkBlk
 
@@ -67,23 +72,53 @@ const (
 	// FragTkDocLineInit represents a documentation line initiator '#'
 	FragTkDocLineInit
 
+	// FragTkDocLineTxt represents the text of a documentation line
+	FragTkDocLineTxt
+
+	// FragTkStr represents a double-quoted string literal token
+	FragTkStr
+
+	// FragTkNum represents a number literal token
+	FragTkNum
+
 	// FragTkSymSep represents a separator ',' token
 	FragTkSymSep
 
 	// FragTkSymEq represents an equals symbol '='
 	FragTkSymEq
 
+	// FragTkSymCol represents a colon symbol ':'
+	FragTkSymCol
+
 	// FragTkSymOpt represents an optionality symbol '?'
 	FragTkSymOpt
 
 	// FragTkSymList represents a list symbol '[]'
 	FragTkSymList
 
+	// FragTkSymMap represents a map key type opening symbol '['
+	FragTkSymMap
+
+	// FragTkSymMapEnd represents a map key type closing symbol ']'
+	FragTkSymMapEnd
+
+	// FragTkAnnot represents an annotation initiator '@'
+	FragTkAnnot
+
+	// FragTkGen represents a type parameter or argument list opening '<'
+	FragTkGen
+
+	// FragTkGenEnd represents a type parameter or argument list closing '>'
+	FragTkGenEnd
+
 	/* TERMINALS (Keywords) */
 
 	// FragTkKwdScm represents a schema declaration keyword fragment
 	FragTkKwdScm
 
+	// FragTkKwdImp represents an import declaration keyword fragment
+	FragTkKwdImp
+
 	// FragTkKwdEnm represents an enum type declaration keyword fragment
 	FragTkKwdEnm
 
@@ -93,6 +128,9 @@ const (
 	// FragTkKwdUnn represents a union type declaration keyword fragment
 	FragTkKwdUnn
 
+	// FragTkKwdScl represents a scalar type declaration keyword fragment
+	FragTkKwdScl
+
 	// FragTkKwdStr represents a struct type declaration keyword fragment
 	FragTkKwdStr
 
@@ -102,6 +140,9 @@ const (
 	// FragTkKwdTrt represents a trait type declaration keyword fragment
 	FragTkKwdTrt
 
+	// FragTkKwdImpl represents a trait implementation keyword fragment
+	FragTkKwdImpl
+
 	// FragTkKwdQry represents a query endpoint declaration keyword fragment
 	FragTkKwdQry
 
@@ -112,12 +153,25 @@ const (
 	// fragment
 	FragTkKwdSub
 
+	// FragTkKwdDepr represents a deprecation keyword fragment
+	FragTkKwdDepr
+
+	// FragTkKwdWhere represents a constraints keyword fragment
+	FragTkKwdWhere
+
+	// FragTkKwdLit represents a literal value keyword fragment
+	// (true, false or null)
+	FragTkKwdLit
+
 	// FragTkIdnScm represents a schema identifier fragment
 	FragTkIdnScm
 
 	// FragTkIdnType represents a type identifier fragment
 	FragTkIdnType
 
+	// FragTkIdnTypeParam represents a type parameter identifier fragment
+	FragTkIdnTypeParam
+
 	// FragTkIdnProp represents a property identifier fragment
 	FragTkIdnProp
 
@@ -127,6 +181,12 @@ const (
 	// FragTkIdnParam represents a parameter identifier fragment
 	FragTkIdnParam
 
+	// FragTkIdnAnnot represents an annotation identifier fragment
+	FragTkIdnAnnot
+
+	// FragTkIdnConstr represents a constraint identifier fragment
+	FragTkIdnConstr
+
 	// FragTkIdnEnumVal represents an enum value identifier fragment
 	FragTkIdnEnumVal
 
@@ -141,6 +201,9 @@ const (
 	// FragDeclSchema represents a schema declaration fragment
 	FragDeclSchema
 
+	// FragDeclImp represents an import declaration fragment
+	FragDeclImp
+
 	// FragDeclAls represents an alias type declaration fragment
 	FragDeclAls
 
@@ -150,6 +213,9 @@ const (
 	// FragDeclUnn represents a union type declaration fragment
 	FragDeclUnn
 
+	// FragDeclScl represents a scalar type declaration fragment
+	FragDeclScl
+
 	// FragDeclStr represents a struct type declaration fragment
 	FragDeclStr
 
@@ -168,6 +234,27 @@ const (
 	// FragDeclSub represents a subscription endpoint type declaration fragment
 	FragDeclSub
 
+	// FragImpls represents a list of implemented traits fragment
+	FragImpls
+
+	// FragDoc represents a documentation block fragment
+	FragDoc
+
+	// FragDepr represents a deprecation marker fragment
+	FragDepr
+
+	// FragAnnots represents a list of annotations fragment
+	FragAnnots
+
+	// FragAnnot represents an annotation fragment
+	FragAnnot
+
+	// FragConstrs represents a list of constraints fragment
+	FragConstrs
+
+	// FragConstr represents a constraint fragment
+	FragConstr
+
 	// FragEnmVals represents an enum values block fragment
 	FragEnmVals
 
@@ -177,6 +264,12 @@ const (
 	// FragRsvProp represents a resolver property fragment
 	FragRsvProp
 
+	// FragTrtProps represents a trait properties block fragment
+	FragTrtProps
+
+	// FragTrtProp represents a trait property fragment
+	FragTrtProp
+
 	// FragParams represents a parameter block-list fragment
 	FragParams
 
@@ -195,6 +288,24 @@ const (
 
 	// FragType represents a type definition
 	FragType
+
+	// FragTypeParams represents a type parameter list fragment
+	FragTypeParams
+
+	// FragTypeArgs represents a type argument list fragment
+	FragTypeArgs
+
+	// FragVal represents a literal value fragment
+	FragVal
+
+	// FragValList represents a list literal fragment
+	FragValList
+
+	// FragValStruct represents a struct literal fragment
+	FragValStruct
+
+	// FragValField represents a struct literal field fragment
+	FragValField
 )
 
 // String stringifies the fragment identifier
@@ -202,6 +313,8 @@ func (id FragID) String() string {
 	switch id {
 	case FragTkSpace:
 		return "TkSpace"
+	case FragTkComment:
+		return "TkComment"
 	case FragTkBlk:
 		return "TkBlk"
 	case FragTkBlkEnd:
@@ -218,44 +331,80 @@ func (id FragID) String() string {
 		return "TkMemAcc"
 	case FragTkDocLineInit:
 		return "TkDocLineInit"
+	case FragTkDocLineTxt:
+		return "TkDocLineTxt"
+	case FragTkStr:
+		return "TkStr"
+	case FragTkNum:
+		return "TkNum"
 	case FragTkSymSep:
 		return "TkSymSep"
 	case FragTkSymEq:
 		return "TkSymEq"
+	case FragTkSymCol:
+		return "TkSymCol"
 	case FragTkSymOpt:
 		return "TkSymOpt"
 	case FragTkSymList:
 		return "TkSymList"
+	case FragTkSymMap:
+		return "TkSymMap"
+	case FragTkSymMapEnd:
+		return "TkSymMapEnd"
+	case FragTkAnnot:
+		return "TkAnnot"
+	case FragTkGen:
+		return "TkGen"
+	case FragTkGenEnd:
+		return "TkGenEnd"
 	case FragTkKwdScm:
 		return "TkKwdScm"
+	case FragTkKwdImp:
+		return "TkKwdImp"
 	case FragTkKwdEnm:
 		return "TkKwdEnm"
 	case FragTkKwdAls:
 		return "TkKwdAls"
 	case FragTkKwdUnn:
 		return "TkKwdUnn"
+	case FragTkKwdScl:
+		return "TkKwdScl"
 	case FragTkKwdStr:
 		return "TkKwdStr"
 	case FragTkKwdRsv:
 		return "TkKwdRsv"
 	case FragTkKwdTrt:
 		return "TkKwdTrt"
+	case FragTkKwdImpl:
+		return "TkKwdImpl"
 	case FragTkKwdQry:
 		return "TkKwdQry"
 	case FragTkKwdMut:
 		return "TkKwdMut"
 	case FragTkKwdSub:
 		return "TkKwdSub"
+	case FragTkKwdDepr:
+		return "TkKwdDepr"
+	case FragTkKwdWhere:
+		return "TkKwdWhere"
+	case FragTkKwdLit:
+		return "TkKwdLit"
 	case FragTkIdnScm:
 		return "TkIdnScm"
 	case FragTkIdnType:
 		return "TkIdnType"
+	case FragTkIdnTypeParam:
+		return "TkIdnTypeParam"
 	case FragTkIdnProp:
 		return "TkIdnProp"
 	case FragTkIdnFld:
 		return "TkIdnFld"
 	case FragTkIdnParam:
 		return "TkIdnParam"
+	case FragTkIdnAnnot:
+		return "TkIdnAnnot"
+	case FragTkIdnConstr:
+		return "TkIdnConstr"
 	case FragTkIdnEnumVal:
 		return "TkIdnEnumVal"
 	case FragTkEnmVal:
@@ -264,12 +413,16 @@ func (id FragID) String() string {
 		return "ScmFile"
 	case FragDeclSchema:
 		return "DeclSchema"
+	case FragDeclImp:
+		return "DeclImp"
 	case FragDeclAls:
 		return "DeclAls"
 	case FragDeclEnm:
 		return "DeclEnm"
 	case FragDeclUnn:
 		return "DeclUnn"
+	case FragDeclScl:
+		return "DeclScl"
 	case FragDeclStr:
 		return "DeclStr"
 	case FragDeclRsv:
@@ -282,12 +435,30 @@ func (id FragID) String() string {
 		return "DeclMut"
 	case FragDeclSub:
 		return "DeclSub"
+	case FragImpls:
+		return "Impls"
+	case FragDoc:
+		return "Doc"
+	case FragDepr:
+		return "Depr"
+	case FragAnnots:
+		return "Annots"
+	case FragAnnot:
+		return "Annot"
+	case FragConstrs:
+		return "Constrs"
+	case FragConstr:
+		return "Constr"
 	case FragEnmVals:
 		return "EnmVals"
 	case FragRsvProps:
 		return "RsvProps"
 	case FragRsvProp:
 		return "RsvProp"
+	case FragTrtProps:
+		return "TrtProps"
+	case FragTrtProp:
+		return "TrtProp"
 	case FragParams:
 		return "Params"
 	case FragParam:
@@ -300,6 +471,18 @@ func (id FragID) String() string {
 		return "UnnOpts"
 	case FragType:
 		return "Type"
+	case FragTypeParams:
+		return "TypeParams"
+	case FragTypeArgs:
+		return "TypeArgs"
+	case FragVal:
+		return "Val"
+	case FragValList:
+		return "ValList"
+	case FragValStruct:
+		return "ValStruct"
+	case FragValField:
+		return "ValField"
 	}
 	return ""
 }
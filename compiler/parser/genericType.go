@@ -0,0 +1,70 @@
+package parser
+
+// maxGenericInstDepth defines the maximum depth of nested instantiations
+// of generic types after which a generic type is considered
+// infinitely recursive
+const maxGenericInstDepth = 10
+
+// genericType represents a generic type declaration
+// that's instantiated for each unique list of type arguments
+type genericType struct {
+	src         Fragment
+	name        string
+	doc         string
+	deprecated  *Deprecation
+	annotations []*Annotation
+	keyword     Keyword
+	params      []*Token
+	lex         *Lexer
+	recursive   bool
+}
+
+// typeEnv represents the type parameter environment of a generic type
+// declaration that's either parsed as a template or instantiated
+type typeEnv struct {
+	name   string
+	params []*Token
+
+	// args maps the type parameter names to the type arguments,
+	// all arguments are nil when parsing the template
+	args map[string]Type
+
+	// instance is the name of the instantiated type,
+	// it's empty when parsing the template
+	instance string
+
+	// depth is the depth of nested instantiations
+	depth int
+}
+
+// isTemplate returns true if a generic type declaration is parsed as
+// a template, otherwise returns false
+func (env *typeEnv) isTemplate() bool {
+	return env != nil && env.instance == ""
+}
+
+// isInstance returns true if a generic type declaration is parsed
+// as an instance, otherwise returns false. Errors that don't depend
+// on the type arguments aren't reported for instances since they're
+// already reported when parsing the template
+func (env *typeEnv) isInstance() bool {
+	return env != nil && env.instance != ""
+}
+
+// arg returns the type argument of the given type parameter and true
+// if name refers to a type parameter, otherwise returns false
+func (env *typeEnv) arg(name string) (Type, bool) {
+	if env == nil {
+		return nil, false
+	}
+	t, isParam := env.args[name]
+	return t, isParam
+}
+
+// instDepth returns the depth of nested instantiations
+func (env *typeEnv) instDepth() int {
+	if env == nil {
+		return 0
+	}
+	return env.depth
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// instantiateGeneric returns the instance of the generic type for the given
+// type arguments. The generic type declaration is parsed with the type
+// parameters substituted by the type arguments if the instance
+// wasn't yet defined before
+func (pr *Parser) instantiateGeneric(
+	at *Token,
+	generic *genericType,
+	args []Type,
+	depth int,
+) Type {
+	argNames := make([]string, len(args))
+	for i, arg := range args {
+		argNames[i] = arg.String()
+	}
+	name := generic.name + "<" + strings.Join(argNames, ", ") + ">"
+
+	// Return the instance if it was already defined before
+	if defined, isDefined := pr.typeByName[name]; isDefined {
+		return defined
+	}
+
+	if generic.recursive {
+		// Infinite recursion was already reported
+		return nil
+	}
+	if depth >= maxGenericInstDepth {
+		generic.recursive = true
+		pr.err(&pErr{
+			at:   at.begin,
+			code: ErrGenericRecurs,
+			message: fmt.Sprintf(
+				"infinitely recursive instantiation of generic type %s "+
+					"(declared at %s)",
+				generic.name,
+				generic.src.Begin(),
+			),
+		})
+		return nil
+	}
+
+	envArgs := make(map[string]Type, len(args))
+	for i, param := range generic.params {
+		envArgs[param.src] = args[i]
+	}
+
+	prevEnv := pr.env
+	pr.env = &typeEnv{
+		name:     generic.name,
+		params:   generic.params,
+		args:     envArgs,
+		instance: name,
+		depth:    depth + 1,
+	}
+	defer func() { pr.env = prevEnv }()
+
+	// Parse the declaration of the instance
+	lex := generic.lex.New()
+	switch generic.keyword {
+	case KeywordAlias:
+		if t := pr.parseDeclAls(lex); t != nil {
+			t.Doc = generic.doc
+			t.Deprecated = generic.deprecated
+			t.Annotations = generic.annotations
+			return t
+		}
+	case KeywordUnion:
+		if t := pr.parseDeclUnn(lex); t != nil {
+			t.Doc = generic.doc
+			t.Deprecated = generic.deprecated
+			t.Annotations = generic.annotations
+			return t
+		}
+	case KeywordStruct:
+		if t := pr.parseDeclStr(lex); t != nil {
+			t.Doc = generic.doc
+			t.Deprecated = generic.deprecated
+			t.Annotations = generic.annotations
+			return t
+		}
+	case KeywordResolver:
+		if t := pr.parseDeclRsv(lex); t != nil {
+			t.Doc = generic.doc
+			t.Deprecated = generic.deprecated
+			t.Annotations = generic.annotations
+			return t
+		}
+	}
+	return nil
+}
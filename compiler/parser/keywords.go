@@ -7,12 +7,18 @@ const (
 	// KeywordSchema represents the 'schema' keyword
 	KeywordSchema Keyword = "schema"
 
+	// KeywordImport represents the 'import' keyword
+	KeywordImport Keyword = "import"
+
 	// KeywordAlias represents the 'alias' keyword
 	KeywordAlias Keyword = "alias"
 
 	// KeywordUnion represents the 'union' keyword
 	KeywordUnion Keyword = "union"
 
+	// KeywordScalar represents the 'scalar' keyword
+	KeywordScalar Keyword = "scalar"
+
 	// KeywordEnum represents the 'enum' keyword
 	KeywordEnum Keyword = "enum"
 
@@ -25,6 +31,9 @@ const (
 	// KeywordTrait represents the 'trait' keyword
 	KeywordTrait Keyword = "trait"
 
+	// KeywordImplements represents the 'implements' keyword
+	KeywordImplements Keyword = "implements"
+
 	// KeywordQuery represents the 'query' keyword
 	KeywordQuery Keyword = "query"
 
@@ -33,4 +42,19 @@ const (
 
 	// KeywordSubscription represents the 'subscription' keyword
 	KeywordSubscription Keyword = "subscription"
+
+	// KeywordDeprecated represents the 'deprecated' keyword
+	KeywordDeprecated Keyword = "deprecated"
+
+	// KeywordWhere represents the 'where' keyword
+	KeywordWhere Keyword = "where"
+
+	// KeywordTrue represents the 'true' literal keyword
+	KeywordTrue Keyword = "true"
+
+	// KeywordFalse represents the 'false' literal keyword
+	KeywordFalse Keyword = "false"
+
+	// KeywordNull represents the 'null' literal keyword
+	KeywordNull Keyword = "null"
 )
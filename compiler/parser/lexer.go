@@ -8,6 +8,10 @@ import (
 type Lexer struct {
 	tail Cursor
 	src  *SourceFile
+
+	// docLine is true when the last token was a documentation line
+	// initiator and the rest of the line is to be read as text
+	docLine bool
 }
 
 // NewLexer creates a new lexer instance
@@ -29,8 +33,9 @@ func (lex *Lexer) Cursor() Cursor { return lex.tail }
 // New creates a new lexer branching off the original one
 func (lex *Lexer) New() *Lexer {
 	return &Lexer{
-		tail: lex.tail,
-		src:  lex.src,
+		tail:    lex.tail,
+		src:     lex.src,
+		docLine: lex.docLine,
 	}
 }
 
@@ -41,15 +46,12 @@ func (lex *Lexer) Peek(expected string) bool {
 		// The source is smaller than the expected target
 		return false
 	}
-	e := 0
 	i := lex.tail.Index + 1
-	for i < uint32(len(expected)) {
-		if lex.src.Src[i] != expected[e] {
+	for e := 0; e < len(expected); e++ {
+		if lex.src.Src[i+uint32(e)] != expected[e] {
 			// The source doesn't match the expected target
 			return false
 		}
-		i++
-		e++
 	}
 	return true
 }
@@ -95,6 +97,66 @@ LOOP:
 	}
 }
 
+// readComment reads either a line comment up until the line-break or EOF
+// or a block comment up until and including its terminator
+func (lex *Lexer) readComment() (*Token, Error) {
+	begin := lex.tail
+	src := lex.src.Src
+	end := uint32(len(src))
+
+	if lex.Peek("/") {
+		// Line comment
+		for lex.tail.Index < end {
+			c := src[lex.tail.Index]
+			if c == '\n' || (c == '\r' && lex.Peek("\n")) {
+				break
+			}
+			lex.tail.Index++
+			lex.tail.Column++
+		}
+		return lex.newToken(begin, FragTkComment), nil
+	}
+
+	// Block comment
+	lex.tail.Index += 2
+	lex.tail.Column += 2
+	for lex.tail.Index < end {
+		c := src[lex.tail.Index]
+		if c == '*' && lex.Peek("/") {
+			lex.tail.Index += 2
+			lex.tail.Column += 2
+			return lex.newToken(begin, FragTkComment), nil
+		}
+		if c == '\n' {
+			lex.tail.Line++
+			lex.tail.Column = 1
+		} else {
+			lex.tail.Column++
+		}
+		lex.tail.Index++
+	}
+	return nil, &pErr{
+		at:      begin,
+		code:    ErrSyntax,
+		message: "unterminated block comment",
+	}
+}
+
+// readDocLineTxt reads the text of a documentation line
+// up until the line-break or EOF
+func (lex *Lexer) readDocLineTxt() *Token {
+	begin := lex.tail
+	for lex.tail.Index < uint32(len(lex.src.Src)) {
+		c := lex.src.Src[lex.tail.Index]
+		if c == '\n' || (c == '\r' && lex.Peek("\n")) {
+			break
+		}
+		lex.tail.Index++
+		lex.tail.Column++
+	}
+	return lex.newToken(begin, FragTkDocLineTxt)
+}
+
 func (lex *Lexer) readLatinAlphanum() *Token {
 	begin := lex.tail
 	for {
@@ -109,6 +171,96 @@ func (lex *Lexer) readLatinAlphanum() *Token {
 	}
 }
 
+// readNum reads a number literal, words beginning with a digit
+// are read as latin alpha-numeric words instead
+func (lex *Lexer) readNum() (*Token, Error) {
+	begin := lex.tail
+	src := lex.src.Src
+	end := uint32(len(src))
+	i := begin.Index
+	readDigits := func() bool {
+		start := i
+		for i < end && isDigit(src[i]) {
+			i++
+		}
+		return i > start
+	}
+
+	negative := src[i] == '-'
+	if negative {
+		i++
+	}
+	if !readDigits() {
+		return nil, &pErr{
+			at:      begin,
+			code:    ErrSyntax,
+			message: "unexpected character '-'",
+		}
+	}
+
+	// Fraction
+	if i+1 < end && src[i] == '.' && isDigit(src[i+1]) {
+		i++
+		readDigits()
+	}
+
+	// Exponent
+	if i < end && (src[i] == 'e' || src[i] == 'E') {
+		j := i + 1
+		if j < end && (src[j] == '+' || src[j] == '-') {
+			j++
+		}
+		if j < end && isDigit(src[j]) {
+			i = j
+			readDigits()
+		}
+	}
+
+	if !negative && i < end && isLatinAlphanum(src, i) {
+		// Not a number
+		return lex.readLatinAlphanum(), nil
+	}
+
+	lex.tail.Column += i - begin.Index
+	lex.tail.Index = i
+	return lex.newToken(begin, FragTkNum), nil
+}
+
+func (lex *Lexer) readStr() (*Token, Error) {
+	begin := lex.tail
+	lex.tail.Index++
+	lex.tail.Column++
+	for {
+		if lex.tail.Index >= uint32(len(lex.src.Src)) {
+			return nil, &pErr{
+				at:      begin,
+				code:    ErrSyntax,
+				message: "unterminated string literal",
+			}
+		}
+		switch lex.src.Src[lex.tail.Index] {
+		case '"':
+			lex.tail.Index++
+			lex.tail.Column++
+			return lex.newToken(begin, FragTkStr), nil
+		case '\\':
+			// Skip the escaped character
+			if lex.tail.Index+1 < uint32(len(lex.src.Src)) {
+				lex.tail.Index++
+				lex.tail.Column++
+			}
+		case '\n', '\r':
+			return nil, &pErr{
+				at:      begin,
+				code:    ErrSyntax,
+				message: "unterminated string literal",
+			}
+		}
+		lex.tail.Index++
+		lex.tail.Column++
+	}
+}
+
 func (lex *Lexer) tryReadSymList() (*Token, Error) {
 	begin := lex.tail
 	if lex.Peek("]") {
@@ -116,6 +268,12 @@ func (lex *Lexer) tryReadSymList() (*Token, Error) {
 		lex.tail.Column += 2
 		return lex.newToken(begin, FragTkSymList), nil
 	}
+	if lex.tail.Index+1 < uint32(len(lex.src.Src)) {
+		// Map key type opening
+		lex.tail.Index++
+		lex.tail.Column++
+		return lex.newToken(begin, FragTkSymMap), nil
+	}
 	return nil, &pErr{
 		at:   begin,
 		code: ErrSyntax,
@@ -126,6 +284,21 @@ func (lex *Lexer) tryReadSymList() (*Token, Error) {
 	}
 }
 
+// skipChar advances the lexer by a single character without reading a token
+func (lex *Lexer) skipChar() {
+	if lex.tail.Index >= uint32(len(lex.src.Src)) {
+		return
+	}
+	if lex.src.Src[lex.tail.Index] == '\n' {
+		lex.tail.Line++
+		lex.tail.Column = 1
+	} else {
+		lex.tail.Column++
+	}
+	lex.tail.Index++
+	lex.docLine = false
+}
+
 func (lex *Lexer) newToken(begin Cursor, id FragID) *Token {
 	if lex.tail.Index == begin.Index {
 		// EOF
@@ -142,6 +315,12 @@ func (lex *Lexer) newToken(begin Cursor, id FragID) *Token {
 
 // Next returns the next token or nil if there is EOF is reached
 func (lex *Lexer) Next() (*Token, Error) {
+	if lex.docLine {
+		lex.docLine = false
+		if tk := lex.readDocLineTxt(); tk != nil {
+			return tk, nil
+		}
+	}
 	begin := lex.tail
 	if lex.tail.Index >= uint32(len(lex.src.Src)) {
 		// EOF
@@ -176,13 +355,35 @@ func (lex *Lexer) Next() (*Token, Error) {
 	case '.':
 		return newSingleRuneTk(FragTkMemAcc), nil
 	case '#':
+		lex.docLine = true
 		return newSingleRuneTk(FragTkDocLineInit), nil
 	case '=':
 		return newSingleRuneTk(FragTkSymEq), nil
+	case ':':
+		return newSingleRuneTk(FragTkSymCol), nil
+	case '@':
+		return newSingleRuneTk(FragTkAnnot), nil
 	case '?':
 		return newSingleRuneTk(FragTkSymOpt), nil
+	case '<':
+		return newSingleRuneTk(FragTkGen), nil
+	case '>':
+		return newSingleRuneTk(FragTkGenEnd), nil
 	case '[':
 		return lex.tryReadSymList()
+	case ']':
+		return newSingleRuneTk(FragTkSymMapEnd), nil
+	case '"':
+		return lex.readStr()
+	case '/':
+		if lex.Peek("/") || lex.Peek("*") {
+			return lex.readComment()
+		}
+	case '-':
+		return lex.readNum()
+	}
+	if isDigit(start) {
+		return lex.readNum()
 	}
 	if isLatinAlphanum(lex.src.Src, lex.tail.Index) {
 		return lex.readLatinAlphanum(), nil
@@ -80,6 +80,46 @@ func TestLexer(t *testing.T) {
 			parser.Cursor{Index: 8, Line: 2, Column: 4},
 		)
 	})
+	t.Run("LineComment", func(t *testing.T) {
+		test(
+			t,
+			"// a { b }\nf",
+			"// a { b }",
+			parser.FragTkComment,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 10, Line: 1, Column: 11},
+		)
+	})
+	t.Run("LineCommentEOF", func(t *testing.T) {
+		test(
+			t,
+			"//",
+			"//",
+			parser.FragTkComment,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 2, Line: 1, Column: 3},
+		)
+	})
+	t.Run("BlockComment", func(t *testing.T) {
+		test(
+			t,
+			"/* a */f",
+			"/* a */",
+			parser.FragTkComment,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 7, Line: 1, Column: 8},
+		)
+	})
+	t.Run("MultilineBlockComment", func(t *testing.T) {
+		test(
+			t,
+			"/* a\n b\n*/ f",
+			"/* a\n b\n*/",
+			parser.FragTkComment,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 10, Line: 3, Column: 3},
+		)
+	})
 	t.Run("parser.FragTkLatinAlphanum", func(t *testing.T) {
 		test(
 			t,
@@ -100,6 +140,96 @@ func TestLexer(t *testing.T) {
 			parser.Cursor{Index: 1, Line: 1, Column: 2},
 		)
 	})
+	t.Run("SymMap", func(t *testing.T) {
+		test(
+			t,
+			"[String]",
+			"[",
+			parser.FragTkSymMap,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		)
+	})
+	t.Run("SymMapEnd", func(t *testing.T) {
+		test(
+			t,
+			"]",
+			"]",
+			parser.FragTkSymMapEnd,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		)
+	})
+	t.Run("Gen", func(t *testing.T) {
+		test(
+			t,
+			"<T>",
+			"<",
+			parser.FragTkGen,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		)
+	})
+	t.Run("GenEnd", func(t *testing.T) {
+		test(
+			t,
+			">",
+			">",
+			parser.FragTkGenEnd,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		)
+	})
+	t.Run("SymCol", func(t *testing.T) {
+		test(
+			t,
+			": x",
+			":",
+			parser.FragTkSymCol,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		)
+	})
+	t.Run("Annot", func(t *testing.T) {
+		test(
+			t,
+			"@index",
+			"@",
+			parser.FragTkAnnot,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		)
+	})
+	t.Run("NumInt", func(t *testing.T) {
+		test(
+			t,
+			"42,",
+			"42",
+			parser.FragTkNum,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 2, Line: 1, Column: 3},
+		)
+	})
+	t.Run("NumNegativeFloat", func(t *testing.T) {
+		test(
+			t,
+			"-3.14e-2)",
+			"-3.14e-2",
+			parser.FragTkNum,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 8, Line: 1, Column: 9},
+		)
+	})
+	t.Run("DigitPrefixedWord", func(t *testing.T) {
+		test(
+			t,
+			"1abc",
+			"1abc",
+			parser.FragTkLatinAlphanum,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 4, Line: 1, Column: 5},
+		)
+	})
 }
 
 // TestLexerScanSequence tests scanning a sequence of tokens
@@ -133,7 +263,7 @@ func TestLexerScanSequence(t *testing.T) {
 			parser.Cursor{Index: 14, Line: 3, Column: 2},
 		},
 		Token{
-			parser.FragTkLatinAlphanum,
+			parser.FragTkNum,
 			"345",
 			parser.Cursor{Index: 14, Line: 3, Column: 2},
 			parser.Cursor{Index: 17, Line: 3, Column: 5},
@@ -173,6 +303,111 @@ func TestLexerScanSequence(t *testing.T) {
 	require.Nil(t, tk)
 }
 
+// TestLexerDocLine tests scanning documentation lines
+func TestLexerDocLine(t *testing.T) {
+	tkz := parser.NewLexer(src("# a {doc} !\r\n#\nx"))
+	require.NotNil(t, tkz)
+
+	type Token struct {
+		id    parser.FragID
+		src   string
+		begin parser.Cursor
+		end   parser.Cursor
+	}
+	expected := []Token{
+		Token{
+			parser.FragTkDocLineInit,
+			"#",
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+		},
+		Token{
+			parser.FragTkDocLineTxt,
+			" a {doc} !",
+			parser.Cursor{Index: 1, Line: 1, Column: 2},
+			parser.Cursor{Index: 11, Line: 1, Column: 12},
+		},
+		Token{
+			parser.FragTkSpace,
+			"\r\n",
+			parser.Cursor{Index: 11, Line: 1, Column: 12},
+			parser.Cursor{Index: 13, Line: 2, Column: 1},
+		},
+		Token{
+			parser.FragTkDocLineInit,
+			"#",
+			parser.Cursor{Index: 13, Line: 2, Column: 1},
+			parser.Cursor{Index: 14, Line: 2, Column: 2},
+		},
+		Token{
+			parser.FragTkSpace,
+			"\n",
+			parser.Cursor{Index: 14, Line: 2, Column: 2},
+			parser.Cursor{Index: 15, Line: 3, Column: 1},
+		},
+		Token{
+			parser.FragTkLatinAlphanum,
+			"x",
+			parser.Cursor{Index: 15, Line: 3, Column: 1},
+			parser.Cursor{Index: 16, Line: 3, Column: 2},
+		},
+	}
+
+	for _, expected := range expected {
+		tk, err := tkz.Next()
+		require.NoError(t, err)
+		require.NotNil(t, tk)
+		require.Equal(t, expected.src, tk.Src())
+		compareCursor(t, expected.begin, tk.Begin())
+		compareCursor(t, expected.end, tk.End())
+		require.Equal(t, expected.id, tk.FragID())
+	}
+
+	tk, err := tkz.Next()
+	require.NoError(t, err)
+	require.Nil(t, tk)
+}
+
+// TestLexerStr tests string literal lexing
+func TestLexerStr(t *testing.T) {
+	tkz := parser.NewLexer(src(`"a \"b\"" ""`))
+	require.NotNil(t, tkz)
+
+	tk, err := tkz.Next()
+	require.NoError(t, err)
+	require.NotNil(t, tk)
+	require.Equal(t, parser.FragTkStr, tk.FragID())
+	require.Equal(t, `"a \"b\""`, tk.Src())
+	compareCursor(t, parser.Cursor{Index: 0, Line: 1, Column: 1}, tk.Begin())
+	compareCursor(t, parser.Cursor{Index: 9, Line: 1, Column: 10}, tk.End())
+
+	tk, err = tkz.NextSkip(parser.Skip{parser.FragTkSpace})
+	require.NoError(t, err)
+	require.NotNil(t, tk)
+	require.Equal(t, parser.FragTkStr, tk.FragID())
+	require.Equal(t, `""`, tk.Src())
+
+	tk, err = tkz.Next()
+	require.NoError(t, err)
+	require.Nil(t, tk)
+}
+
+// TestLexerComments tests skipping comments
+func TestLexerComments(t *testing.T) {
+	tkz := parser.NewLexer(src("/* a\nb */ // c\n  word"))
+	require.NotNil(t, tkz)
+
+	tk, err := tkz.NextSkip(parser.Skip{
+		parser.FragTkSpace,
+		parser.FragTkComment,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, tk)
+	require.Equal(t, parser.FragTkLatinAlphanum, tk.FragID())
+	require.Equal(t, "word", tk.Src())
+	compareCursor(t, parser.Cursor{Index: 17, Line: 3, Column: 3}, tk.Begin())
+}
+
 // TestLexerSyntaxErr tests lexer syntax errors
 func TestLexerSyntaxErr(t *testing.T) {
 	test := func(
@@ -205,6 +440,41 @@ func TestLexerSyntaxErr(t *testing.T) {
 			parser.Cursor{Index: 0, Line: 1, Column: 1},
 		)
 	})
+	t.Run("UnterminatedStr", func(t *testing.T) {
+		test(
+			t,
+			`"abc`,
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+		)
+	})
+	t.Run("MinusWithoutDigits", func(t *testing.T) {
+		test(
+			t,
+			"-x",
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+		)
+	})
+	t.Run("MultilineStr", func(t *testing.T) {
+		test(
+			t,
+			"\"abc\ndef\"",
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+		)
+	})
+	t.Run("UnterminatedBlockComment", func(t *testing.T) {
+		test(
+			t,
+			"/* abc\ndef *",
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+		)
+	})
+	t.Run("SingleSlash", func(t *testing.T) {
+		test(
+			t,
+			"/ abc",
+			parser.Cursor{Index: 0, Line: 1, Column: 1},
+		)
+	})
 }
 
 // TestLexerNextExpect tests lexer syntax errors
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"io/ioutil"
+	"path/filepath"
+)
+
+// SourceLoader represents a loader of imported source files
+type SourceLoader interface {
+	// Load loads the source file identified by path.
+	// Relative paths are relative to the importing file
+	Load(path string, importer *File) (SourceFile, error)
+}
+
+// FileLoader represents a source loader reading imported source files
+// from the file system
+type FileLoader struct{}
+
+// Load implements the SourceLoader interface
+func (ld FileLoader) Load(path string, importer *File) (SourceFile, error) {
+	if !filepath.IsAbs(path) && importer != nil {
+		path = filepath.Join(importer.Path, path)
+	}
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return SourceFile{}, err
+	}
+	return SourceFile{
+		File: File{
+			Name: filepath.Base(path),
+			Path: filepath.Dir(path),
+		},
+		Src: string(contents),
+	}, nil
+}
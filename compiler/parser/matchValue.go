@@ -0,0 +1,243 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// decimalLiteral matches fixed-point decimal number literals
+var decimalLiteral = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// uuidLiteral matches RFC4122 UUID string representations
+var uuidLiteral = regexp.MustCompile(
+	`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-` +
+		`[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+)
+
+// matchValue returns a description of the mismatch between the given value
+// and the given type or an empty string if the value matches the type.
+// Unresolved types match any value since they're reported elsewhere
+func matchValue(v *Value, t Type) string {
+	t = unaliased(t)
+	if t == nil {
+		return ""
+	}
+
+	mismatch := func() string {
+		return fmt.Sprintf("%s doesn't match type %s", v.Kind, t)
+	}
+
+	switch tp := t.(type) {
+	case *TypeOptional:
+		if v.Kind == ValueKindNull {
+			return ""
+		}
+		return matchValue(v, tp.StoreType)
+
+	case *TypeList:
+		if v.Kind != ValueKindList {
+			return mismatch()
+		}
+		for i, item := range v.Items {
+			if m := matchValue(item, tp.StoreType); m != "" {
+				return fmt.Sprintf("item %d: %s", i, m)
+			}
+		}
+		return ""
+
+	case TypeStdBool:
+		if v.Kind != ValueKindBool {
+			return mismatch()
+		}
+		return ""
+
+	case TypeStdByte, TypeStdUint8:
+		return matchUint(v, t, 8)
+	case TypeStdUint16:
+		return matchUint(v, t, 16)
+	case TypeStdUint32:
+		return matchUint(v, t, 32)
+	case TypeStdUint64:
+		return matchUint(v, t, 64)
+	case TypeStdInt8:
+		return matchInt(v, t, 8)
+	case TypeStdInt16:
+		return matchInt(v, t, 16)
+	case TypeStdInt32:
+		return matchInt(v, t, 32)
+	case TypeStdInt64:
+		return matchInt(v, t, 64)
+	case TypeStdFloat32:
+		return matchFloat(v, t, 32)
+	case TypeStdFloat64:
+		return matchFloat(v, t, 64)
+
+	case TypeStdDecimal:
+		if v.Kind != ValueKindNumber {
+			return mismatch()
+		}
+		if !decimalLiteral.MatchString(v.Literal) {
+			return fmt.Sprintf("%s isn't a valid %s", v.Literal, t)
+		}
+		return ""
+
+	case TypeStdString:
+		if v.Kind != ValueKindString {
+			return mismatch()
+		}
+		return ""
+
+	case TypeStdTime:
+		if v.Kind != ValueKindString {
+			return mismatch()
+		}
+		if _, err := time.Parse(time.RFC3339, v.Literal); err != nil {
+			return fmt.Sprintf("%q isn't a valid RFC3339 time", v.Literal)
+		}
+		return ""
+
+	case TypeStdDate:
+		if v.Kind != ValueKindString {
+			return mismatch()
+		}
+		if _, err := time.Parse("2006-01-02", v.Literal); err != nil {
+			return fmt.Sprintf("%q isn't a valid RFC3339 full-date", v.Literal)
+		}
+		return ""
+
+	case TypeStdDuration:
+		if v.Kind != ValueKindString {
+			return mismatch()
+		}
+		if _, err := time.ParseDuration(v.Literal); err != nil {
+			return fmt.Sprintf("%q isn't a valid duration", v.Literal)
+		}
+		return ""
+
+	case TypeStdUUID:
+		if v.Kind != ValueKindString {
+			return mismatch()
+		}
+		if !uuidLiteral.MatchString(v.Literal) {
+			return fmt.Sprintf("%q isn't a valid UUID", v.Literal)
+		}
+		return ""
+
+	case *TypeScalar:
+		return matchValue(v, tp.BaseType)
+
+	case *TypeEnum:
+		if v.Kind != ValueKindEnum {
+			return mismatch()
+		}
+		for _, val := range tp.Values {
+			if val.Name == v.Literal {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%s isn't a value of enum %s", v.Literal, t)
+
+	case *TypeStruct:
+		if v.Kind != ValueKindStruct {
+			return mismatch()
+		}
+		for _, fld := range v.Fields {
+			var structField *StructField
+			for _, sf := range tp.Fields {
+				if sf.Name == fld.Name {
+					structField = sf
+					break
+				}
+			}
+			if structField == nil {
+				return fmt.Sprintf("struct %s has no field %s", t, fld.Name)
+			}
+			if m := matchValue(fld.Value, structField.Type); m != "" {
+				return fmt.Sprintf("field %s: %s", fld.Name, m)
+			}
+		}
+		for _, sf := range tp.Fields {
+			if _, isOptional := unaliased(sf.Type).(*TypeOptional); isOptional {
+				continue
+			}
+			if v.Field(sf.Name) == nil {
+				return fmt.Sprintf(
+					"missing value for field %s of struct %s",
+					sf.Name,
+					t,
+				)
+			}
+		}
+		return ""
+
+	case *TypeUnion:
+		for _, option := range tp.Types {
+			if matchValue(v, option) == "" {
+				return ""
+			}
+		}
+		return fmt.Sprintf(
+			"%s doesn't match any option type of union %s",
+			v.Kind,
+			t,
+		)
+	}
+
+	// None, maps, resolvers and traits have no literal representation
+	return mismatch()
+}
+
+// unaliased follows the alias chain returning the aliased type
+// or nil if the chain is either unresolved or cyclic
+func unaliased(t Type) Type {
+	visited := map[*TypeAlias]bool{}
+	for {
+		alias, isAlias := t.(*TypeAlias)
+		if !isAlias {
+			return t
+		}
+		if visited[alias] {
+			return nil
+		}
+		visited[alias] = true
+		t = alias.AliasedType
+	}
+}
+
+// matchUint returns a mismatch description if v isn't
+// an unsigned integer fitting into the given number of bits
+func matchUint(v *Value, t Type, bits int) string {
+	if v.Kind != ValueKindNumber {
+		return fmt.Sprintf("%s doesn't match type %s", v.Kind, t)
+	}
+	if _, err := strconv.ParseUint(v.Literal, 10, bits); err != nil {
+		return fmt.Sprintf("%s isn't a valid %s", v.Literal, t)
+	}
+	return ""
+}
+
+// matchInt returns a mismatch description if v isn't
+// a signed integer fitting into the given number of bits
+func matchInt(v *Value, t Type, bits int) string {
+	if v.Kind != ValueKindNumber {
+		return fmt.Sprintf("%s doesn't match type %s", v.Kind, t)
+	}
+	if _, err := strconv.ParseInt(v.Literal, 10, bits); err != nil {
+		return fmt.Sprintf("%s isn't a valid %s", v.Literal, t)
+	}
+	return ""
+}
+
+// matchFloat returns a mismatch description if v isn't
+// a floating point number fitting into the given number of bits
+func matchFloat(v *Value, t Type, bits int) string {
+	if v.Kind != ValueKindNumber {
+		return fmt.Sprintf("%s doesn't match type %s", v.Kind, t)
+	}
+	if _, err := strconv.ParseFloat(v.Literal, bits); err != nil {
+		return fmt.Sprintf("%s is out of range of type %s", v.Literal, t)
+	}
+	return ""
+}
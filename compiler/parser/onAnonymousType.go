@@ -12,6 +12,7 @@ func (pr *Parser) onAnonymousType(newType Type) Type {
 	switch newType.(type) {
 	case *TypeOptional:
 	case *TypeList:
+	case *TypeMap:
 	default:
 		panic(fmt.Errorf("%s isn't an anonymous type", name))
 	}
@@ -23,8 +24,7 @@ func (pr *Parser) onAnonymousType(newType Type) Type {
 	}
 
 	// Issue a new type ID
-	pr.lastIssuedTypeID += TypeID(1)
-	newID := pr.lastIssuedTypeID
+	newID := pr.issueTypeID(name)
 
 	// Register the newly defined anonymous type
 	pr.typeByID[newID] = newType
@@ -38,7 +38,26 @@ func (pr *Parser) onAnonymousType(newType Type) Type {
 		t.ID = newID
 	case *TypeList:
 		t.ID = newID
+	case *TypeMap:
+		t.ID = newID
 	}
 
 	return newType
 }
+
+// registerAnonymousTypes registers all containers of the given type chain
+// as anonymous types starting with the innermost one and returns the
+// registered outermost type. Types other than containers are returned as is
+func (pr *Parser) registerAnonymousTypes(t Type) Type {
+	switch v := t.(type) {
+	case *TypeOptional:
+		v.StoreType = pr.registerAnonymousTypes(v.StoreType)
+	case *TypeList:
+		v.StoreType = pr.registerAnonymousTypes(v.StoreType)
+	case *TypeMap:
+		v.StoreType = pr.registerAnonymousTypes(v.StoreType)
+	default:
+		return t
+	}
+	return pr.onAnonymousType(t)
+}
@@ -0,0 +1,62 @@
+package parser
+
+import "fmt"
+
+// onGenericDecl is executed when a generic type declaration template
+// was parsed. It checks the generic type for name collisions
+// and redeclarations and registers it in the parser's context.
+// lex must be positioned at the beginning of the declaration
+func (pr *Parser) onGenericDecl(
+	keyword Keyword,
+	lex *Lexer,
+	src Fragment,
+	doc string,
+	deprecated *Deprecation,
+	annotations []*Annotation,
+) {
+	name := pr.env.name
+
+	// Check for collisions with reserved primitive types
+	if stdTypeByName(name) != nil {
+		pr.err(&pErr{
+			at:   src.Begin(),
+			code: ErrTypeRedecl,
+			message: fmt.Sprintf(
+				"Redeclaration of type %s (reserved primitive type)",
+				name,
+			),
+		})
+		return
+	}
+
+	// Check for collisions with other user-defined types
+	var reservedBySrcNode Fragment
+	if reservedBy, isReserved := pr.typeByName[name]; isReserved {
+		reservedBySrcNode = reservedBy.Source()
+	} else if reservedBy, isReserved := pr.genericByName[name]; isReserved {
+		reservedBySrcNode = reservedBy.src
+	}
+	if reservedBySrcNode != nil {
+		pr.err(&pErr{
+			at:   src.Begin(),
+			code: ErrTypeRedecl,
+			message: fmt.Sprintf("Redeclaration of type %s "+
+				"(previous declaration at %s)",
+				name,
+				reservedBySrcNode.Begin(),
+			),
+		})
+		return
+	}
+
+	pr.genericByName[name] = &genericType{
+		src:         src,
+		name:        name,
+		doc:         doc,
+		deprecated:  deprecated,
+		annotations: annotations,
+		keyword:     keyword,
+		params:      pr.env.params,
+		lex:         lex,
+	}
+}
@@ -5,6 +5,11 @@ import "fmt"
 // onGraphNode returns true if the registration of a new graph node
 // was successful, otherwise returns false
 func (pr *Parser) onGraphNode(newNode GraphNode) bool {
+	if pr.env.isTemplate() {
+		// Graph nodes of templates are defined by their instances
+		return true
+	}
+
 	// Prepare
 	var errCodeRedecl ErrCode
 	targetType := "<unknown>"
@@ -16,12 +21,18 @@ func (pr *Parser) onGraphNode(newNode GraphNode) bool {
 	case *ResolverProperty:
 		errCodeRedecl = ErrResolverPropRedecl
 		targetType = "resolver property"
+	case *TraitProperty:
+		errCodeRedecl = ErrTraitPropRedecl
+		targetType = "trait property"
 	case *Query:
 		errCodeRedecl = ErrGraphRootNodeRedecl
 		targetType = "graph root node"
 	case *Mutation:
 		errCodeRedecl = ErrGraphRootNodeRedecl
 		targetType = "graph root node"
+	case *Subscription:
+		errCodeRedecl = ErrGraphRootNodeRedecl
+		targetType = "graph root node"
 	}
 
 	// Check for redeclaration
@@ -42,19 +53,23 @@ func (pr *Parser) onGraphNode(newNode GraphNode) bool {
 	}
 
 	// Assign unique identifier and register node
-	pr.lastIssuedGraphID += GraphNodeID(1)
-	newID := pr.lastIssuedGraphID
+	newID := pr.issueGraphNodeID(newNodeName)
 	switch newNode := newNode.(type) {
 	case *StructField:
 		newNode.GraphID = newID
 	case *ResolverProperty:
 		newNode.GraphID = newID
+	case *TraitProperty:
+		newNode.GraphID = newID
 	case *Query:
 		newNode.GraphID = newID
 		pr.mod.QueryEndpoints = append(pr.mod.QueryEndpoints, newNode)
 	case *Mutation:
 		newNode.GraphID = newID
 		pr.mod.Mutations = append(pr.mod.Mutations, newNode)
+	case *Subscription:
+		newNode.GraphID = newID
+		pr.mod.Subscriptions = append(pr.mod.Subscriptions, newNode)
 	}
 
 	pr.mod.GraphNodes = append(pr.mod.GraphNodes, newNode)
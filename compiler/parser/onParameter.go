@@ -2,9 +2,13 @@ package parser
 
 // onParameter defines a new parameter assigning it a unique identifier
 func (pr *Parser) onParameter(newParam *Parameter) {
+	if pr.env.isTemplate() {
+		// Parameters of templates are defined by their instances
+		return
+	}
+
 	// Register a new parameter
-	pr.lastIssuedParamID += ParamID(1)
-	newParam.ID = pr.lastIssuedParamID
+	newParam.ID = pr.issueParamID(newParam)
 
 	pr.paramByID[newParam.ID] = newParam
 }
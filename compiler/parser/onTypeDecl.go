@@ -6,6 +6,12 @@ import "fmt"
 // It check the new type for name collisions and redeclarations
 // and registers it in the parser's context if necessary
 func (pr *Parser) onTypeDecl(newType Type) {
+	if pr.env.isTemplate() {
+		// Generic type declaration templates are registered
+		// by onGenericDecl once the declaration is parsed
+		return
+	}
+
 	src := newType.Source()
 	name := newType.String()
 
@@ -23,8 +29,13 @@ func (pr *Parser) onTypeDecl(newType Type) {
 	}
 
 	// Check for collisions with other user-defined types
+	var reservedBySrcNode Fragment
 	if reservedBy, isReserved := pr.typeByName[name]; isReserved {
-		reservedBySrcNode := reservedBy.Source()
+		reservedBySrcNode = reservedBy.Source()
+	} else if reservedBy, isReserved := pr.genericByName[name]; isReserved {
+		reservedBySrcNode = reservedBy.src
+	}
+	if reservedBySrcNode != nil {
 		pr.err(&pErr{
 			at:   src.Begin(),
 			code: ErrTypeRedecl,
@@ -38,8 +49,7 @@ func (pr *Parser) onTypeDecl(newType Type) {
 	}
 
 	// Issue a new type ID
-	pr.lastIssuedTypeID += TypeID(1)
-	newID := pr.lastIssuedTypeID
+	newID := pr.issueTypeID(name)
 
 	// Register the newly defined type
 	pr.mod.Types = append(pr.mod.Types, newType)
@@ -51,6 +61,9 @@ func (pr *Parser) onTypeDecl(newType Type) {
 	case *TypeAlias:
 		t.terminalType.ID = newID
 		pr.mod.AliasTypes = append(pr.mod.AliasTypes, newType)
+	case *TypeScalar:
+		t.terminalType.ID = newID
+		pr.mod.ScalarTypes = append(pr.mod.ScalarTypes, newType)
 	case *TypeEnum:
 		t.terminalType.ID = newID
 		pr.mod.EnumTypes = append(pr.mod.EnumTypes, newType)
@@ -63,5 +76,8 @@ func (pr *Parser) onTypeDecl(newType Type) {
 	case *TypeResolver:
 		t.terminalType.ID = newID
 		pr.mod.ResolverTypes = append(pr.mod.ResolverTypes, newType)
+	case *TypeTrait:
+		t.terminalType.ID = newID
+		pr.mod.TraitTypes = append(pr.mod.TraitTypes, newType)
 	}
 }
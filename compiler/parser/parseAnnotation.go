@@ -0,0 +1,80 @@
+package parser
+
+// parseAnnotation parses an annotation
+func (pr *Parser) parseAnnotation(lex *Lexer) *Annotation {
+	// Read '@'
+	fInit, err := readToken(lex, FragTkAnnot, "annotation '@'")
+	if pr.err(err) {
+		return nil
+	}
+
+	// Read name
+	fName, err := lex.NextExpect(
+		FragTkLatinAlphanum,
+		"expected annotation name",
+	)
+	if pr.err(err) {
+		return nil
+	}
+	fName.id = FragTkIdnAnnot
+	if pr.err(verify(fName, "annotation name", lowerCamelCase)) {
+		return nil
+	}
+
+	newAnnotation := &Annotation{
+		Name: fName.src,
+		Args: []*Value{},
+	}
+	frags := []Fragment{fInit, fName}
+
+	// Parse the arguments if any
+	tk, err := lex.New().Next()
+	if pr.err(err) {
+		return nil
+	}
+	if tk != nil && tk.id == FragTkPar {
+		_, _ = lex.Next()
+		frags = append(frags, tk)
+
+		for {
+			tk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+			if pr.err(err) {
+				return nil
+			}
+			if tk != nil && tk.id == FragTkParEnd {
+				// End of the argument list
+				_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+				frags = append(frags, tk)
+				break
+			}
+
+			arg := pr.parseValue(lex)
+			if arg == nil {
+				return nil
+			}
+			frags = append(frags, arg.Src)
+			newAnnotation.Args = append(newAnnotation.Args, arg)
+
+			// Read the separator unless the list ends
+			sepTk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+			if pr.err(err) {
+				return nil
+			}
+			if sepTk != nil && sepTk.id == FragTkParEnd {
+				continue
+			}
+			separator, err := readToken(
+				lex,
+				FragTkSymSep,
+				"annotation argument separator ','",
+			)
+			if pr.err(err) {
+				return nil
+			}
+			frags = append(frags, separator)
+		}
+	}
+
+	newAnnotation.Src = NewConstruct(lex, FragAnnot, frags...)
+	return newAnnotation
+}
@@ -0,0 +1,68 @@
+package parser
+
+import "fmt"
+
+// parseConstraint parses a value constraint
+func (pr *Parser) parseConstraint(lex *Lexer) *Constraint {
+	// Read constraint name
+	fName, err := readWord(
+		lex,
+		"constraint",
+		FragTkIdnConstr,
+		lowerCamelCase,
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	kind := constraintKindByName(fName.src)
+	if kind == 0 {
+		pr.err(&pErr{
+			at:      fName.begin,
+			code:    ErrSyntax,
+			message: fmt.Sprintf("unknown constraint '%s'", fName.src),
+		})
+		return nil
+	}
+
+	newConstraint := &Constraint{Kind: kind}
+
+	if kind == ConstraintNonEmpty {
+		// Constraint without argument
+		newConstraint.Src = NewConstruct(lex, FragConstr, fName)
+		return newConstraint
+	}
+
+	// Read '('
+	fOpening, err := lex.NextExpect(
+		FragTkPar,
+		"expected constraint argument opening '('",
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Read the argument
+	newConstraint.Value = pr.parseValue(lex)
+	if newConstraint.Value == nil {
+		return nil
+	}
+
+	// Read ')'
+	fClosing, err := readToken(
+		lex,
+		FragTkParEnd,
+		"constraint argument closing ')'",
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	newConstraint.Src = NewConstruct(lex, FragConstr,
+		fName,
+		fOpening,
+		newConstraint.Value.Src,
+		fClosing,
+	)
+	return newConstraint
+}
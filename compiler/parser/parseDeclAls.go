@@ -23,6 +23,12 @@ func (pr *Parser) parseDeclAls(lex *Lexer) *TypeAlias {
 		return nil
 	}
 
+	// Parse type parameters (if any)
+	fTypeParams, name, parsed := pr.parseOptTypeParams(lex, fTypeID)
+	if !parsed {
+		return nil
+	}
+
 	// Read '='
 	fSymEq, err := readToken(lex, FragTkSymEq, "equals sign")
 	if pr.err(err) {
@@ -32,7 +38,7 @@ func (pr *Parser) parseDeclAls(lex *Lexer) *TypeAlias {
 	// Instantiate type
 	newType := &TypeAlias{
 		terminalType: terminalType{
-			Name: fTypeID.src,
+			Name: name,
 		},
 	}
 
@@ -42,11 +48,12 @@ func (pr *Parser) parseDeclAls(lex *Lexer) *TypeAlias {
 		return nil
 	}
 
+	frags := []Fragment{fDeclKeyword, fTypeID}
+	if fTypeParams != nil {
+		frags = append(frags, fTypeParams)
+	}
 	newType.Src = NewConstruct(lex, FragDeclAls,
-		fDeclKeyword,
-		fTypeID,
-		fSymEq,
-		fType,
+		append(frags, fSymEq, fType)...,
 	)
 
 	// Define the type
@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseDeclImp parses an import declaration
+// and the imported source file if it wasn't yet imported before
+func (pr *Parser) parseDeclImp(lex *Lexer) Fragment {
+	// Read keyword
+	fDeclKeyword, err := readWordExact(
+		lex,
+		KeywordImport,
+		FragTkKwdImp,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Read the path of the imported file
+	fPath, err := readToken(lex, FragTkStr, "imported file path")
+	if pr.err(err) {
+		return nil
+	}
+	path, unquoteErr := strconv.Unquote(fPath.src)
+	if unquoteErr != nil || path == "" {
+		pr.err(&pErr{
+			at:      fPath.begin,
+			code:    ErrSyntax,
+			message: fmt.Sprintf("illegal import path %s", fPath.src),
+		})
+		return nil
+	}
+
+	frag := NewConstruct(lex, FragDeclImp,
+		fDeclKeyword,
+		fPath,
+	)
+
+	// Load the imported file
+	importer := pr.importStack[len(pr.importStack)-1]
+	source, loadErr := pr.loader.Load(path, importer)
+	if loadErr != nil {
+		pr.err(&pErr{
+			at:   fPath.begin,
+			code: ErrImportLoad,
+			message: fmt.Sprintf(
+				"couldn't load imported file %s: %s",
+				path,
+				loadErr,
+			),
+		})
+		return nil
+	}
+	filePath := source.filePath()
+
+	// Make sure the import isn't cyclic
+	for i, fl := range pr.importStack {
+		if fl.filePath() != filePath {
+			continue
+		}
+		chain := make([]string, 0, len(pr.importStack)-i+1)
+		for _, fl := range pr.importStack[i:] {
+			chain = append(chain, fl.Name)
+		}
+		chain = append(chain, fl.Name)
+		pr.err(&pErr{
+			at:   fPath.begin,
+			code: ErrImportCycle,
+			message: fmt.Sprintf(
+				"Import cycle: %s",
+				strings.Join(chain, " -> "),
+			),
+		})
+		return nil
+	}
+
+	// Don't parse files repeatedly
+	if _, isImported := pr.importedFiles[filePath]; isImported {
+		return frag
+	}
+
+	// Parse the imported file
+	importedLex := NewLexer(source)
+	pr.importedFiles[filePath] = &importedLex.src.File
+	pr.importStack = append(pr.importStack, &importedLex.src.File)
+	fFile := pr.parseScmFile(importedLex)
+	pr.importStack = pr.importStack[:len(pr.importStack)-1]
+	if fFile == nil {
+		return nil
+	}
+
+	return frag
+}
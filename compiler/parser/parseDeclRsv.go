@@ -23,13 +23,29 @@ func (pr *Parser) parseDeclRsv(lex *Lexer) *TypeResolver {
 		return nil
 	}
 
+	// Parse type parameters (if any)
+	fTypeParams, name, parsed := pr.parseOptTypeParams(lex, fType)
+	if !parsed {
+		return nil
+	}
+
 	// Create a new resolver type instance
 	newResolver := &TypeResolver{
 		terminalType: terminalType{
-			Name: fType.src,
+			Name: name,
 		},
 	}
 
+	// Parse implemented traits and set them when they're resolved
+	fImpls, parsed := pr.parseOptImpls(
+		lex,
+		newResolver,
+		func(ts []*TypeTrait) { newResolver.Implements = ts },
+	)
+	if !parsed {
+		return nil
+	}
+
 	// Parse properties
 	fProps, props := pr.parseRsvProps(lex, newResolver)
 	if fProps == nil {
@@ -37,10 +53,15 @@ func (pr *Parser) parseDeclRsv(lex *Lexer) *TypeResolver {
 	}
 	newResolver.Properties = props
 
+	frags := []Fragment{fDeclKeyword, fType}
+	if fTypeParams != nil {
+		frags = append(frags, fTypeParams)
+	}
+	if fImpls != nil {
+		frags = append(frags, fImpls)
+	}
 	newResolver.Src = NewConstruct(lex, FragDeclRsv,
-		fDeclKeyword,
-		fType,
-		fProps,
+		append(frags, fProps)...,
 	)
 
 	// Define the type
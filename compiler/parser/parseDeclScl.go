@@ -0,0 +1,87 @@
+package parser
+
+import "fmt"
+
+func (pr *Parser) parseDeclScl(lex *Lexer) *TypeScalar {
+	// Read keyword
+	fDeclKeyword, err := readWordExact(
+		lex,
+		KeywordScalar,
+		FragTkKwdScl,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Read type ID
+	fTypeID, err := readWord(
+		lex,
+		"scalar type identifier",
+		FragTkIdnType,
+		capitalizedCamelCase,
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Instantiate type
+	newType := &TypeScalar{
+		terminalType: terminalType{
+			Name: fTypeID.src,
+		},
+	}
+
+	// Read base type and set it when it's determined
+	var fType Fragment
+	fType = pr.parseTypeDesig(lex, func(t Type) {
+		// Make sure the base type is a primitive
+		// after all other types are resolved
+		pr.deferJob(func() {
+			if !isScalarBaseType(t) {
+				pr.err(&pErr{
+					at:   fType.Begin(),
+					code: ErrScalarBaseIllegal,
+					message: fmt.Sprintf(
+						"illegal base type %s of scalar type %s "+
+							"(expected a primitive or an alias of it)",
+						t,
+						newType.Name,
+					),
+				})
+			}
+		})
+
+		// Make sure the constraints are applicable to the base type
+		// after all other types are resolved
+		if len(newType.Constraints) > 0 {
+			pr.deferJob(func() {
+				pr.checkConstraints(newType.Constraints, t)
+			})
+		}
+
+		newType.BaseType = t
+	})
+	if fType == nil {
+		return nil
+	}
+
+	frags := []Fragment{fDeclKeyword, fTypeID, fType}
+
+	// Parse the constraints if any
+	fConstrs, constraints, parsed := pr.parseOptConstraints(lex)
+	if !parsed {
+		return nil
+	}
+	if fConstrs != nil {
+		newType.Constraints = constraints
+		frags = append(frags, fConstrs)
+	}
+
+	newType.Src = NewConstruct(lex, FragDeclScl, frags...)
+
+	// Define the type
+	pr.onTypeDecl(newType)
+
+	return newType
+}
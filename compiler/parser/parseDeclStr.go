@@ -23,13 +23,29 @@ func (pr *Parser) parseDeclStr(lex *Lexer) *TypeStruct {
 		return nil
 	}
 
+	// Parse type parameters (if any)
+	fTypeParams, name, parsed := pr.parseOptTypeParams(lex, fType)
+	if !parsed {
+		return nil
+	}
+
 	// Create a new struct type instance
 	newStruct := &TypeStruct{
 		terminalType: terminalType{
-			Name: fType.src,
+			Name: name,
 		},
 	}
 
+	// Parse implemented traits and set them when they're resolved
+	fImpls, parsed := pr.parseOptImpls(
+		lex,
+		newStruct,
+		func(ts []*TypeTrait) { newStruct.Implements = ts },
+	)
+	if !parsed {
+		return nil
+	}
+
 	// Parse fields
 	fFields, fields := pr.parseStrFields(lex, newStruct)
 	if fFields == nil {
@@ -37,10 +53,15 @@ func (pr *Parser) parseDeclStr(lex *Lexer) *TypeStruct {
 	}
 	newStruct.Fields = fields
 
+	frags := []Fragment{fDeclKeyword, fType}
+	if fTypeParams != nil {
+		frags = append(frags, fTypeParams)
+	}
+	if fImpls != nil {
+		frags = append(frags, fImpls)
+	}
 	newStruct.Src = NewConstruct(lex, FragDeclStr,
-		fDeclKeyword,
-		fType,
-		fFields,
+		append(frags, fFields)...,
 	)
 
 	// Define the type
@@ -0,0 +1,74 @@
+package parser
+
+func (pr *Parser) parseDeclSub(lex *Lexer) *Subscription {
+	// Read keyword
+	fDeclKeyword, err := readWordExact(
+		lex,
+		KeywordSubscription,
+		FragTkKwdSub,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Read endpoint name
+	fName, err := readWord(
+		lex,
+		"endpoint name",
+		FragTkIdnProp,
+		lowerCamelCase,
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Create a new subscription endpoint instance
+	newSubscription := &Subscription{
+		Name: fName.src,
+	}
+
+	// Parse parameters
+	fParams, params, parsed := pr.parseOptParams(lex, newSubscription)
+	if !parsed {
+		return nil
+	}
+	newSubscription.Parameters = params
+
+	// Read type ID
+	fType := pr.parseTypeDesig(lex, func(t Type) {
+		if _, isNone := t.(TypeStdNone); isNone {
+			pr.err(&pErr{
+				at:      fDeclKeyword.begin,
+				code:    ErrSyntax,
+				message: "Subscription endpoint resolves to None",
+			})
+		}
+		newSubscription.Type = t
+	})
+	if fType == nil {
+		return nil
+	}
+
+	if fParams != nil {
+		newSubscription.Src = NewConstruct(lex, FragDeclSub,
+			fDeclKeyword,
+			fName,
+			fParams,
+			fType,
+		)
+	} else {
+		newSubscription.Src = NewConstruct(lex, FragDeclSub,
+			fDeclKeyword,
+			fName,
+			fType,
+		)
+	}
+
+	// Define the endpoint
+	if !pr.onGraphNode(newSubscription) {
+		return nil
+	}
+
+	return newSubscription
+}
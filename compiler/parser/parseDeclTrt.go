@@ -0,0 +1,50 @@
+package parser
+
+func (pr *Parser) parseDeclTrt(lex *Lexer) *TypeTrait {
+	// Read keyword
+	fDeclKeyword, err := readWordExact(
+		lex,
+		KeywordTrait,
+		FragTkKwdTrt,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Read type ID
+	fType, err := readWord(
+		lex,
+		"trait type identifier",
+		FragTkIdnType,
+		capitalizedCamelCase,
+	)
+	if pr.err(err) {
+		return nil
+	}
+
+	// Create a new trait type instance
+	newTrait := &TypeTrait{
+		terminalType: terminalType{
+			Name: fType.src,
+		},
+	}
+
+	// Parse properties
+	fProps, props := pr.parseTrtProps(lex, newTrait)
+	if fProps == nil {
+		return nil
+	}
+	newTrait.Properties = props
+
+	newTrait.Src = NewConstruct(lex, FragDeclTrt,
+		fDeclKeyword,
+		fType,
+		fProps,
+	)
+
+	// Define the type
+	pr.onTypeDecl(newTrait)
+
+	return newTrait
+}
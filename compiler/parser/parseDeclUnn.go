@@ -23,10 +23,16 @@ func (pr *Parser) parseDeclUnn(lex *Lexer) *TypeUnion {
 		return nil
 	}
 
-	// Create a new resolver type instance
+	// Parse type parameters (if any)
+	fTypeParams, name, parsed := pr.parseOptTypeParams(lex, fType)
+	if !parsed {
+		return nil
+	}
+
+	// Create a new union type instance
 	newUnion := &TypeUnion{
 		terminalType: terminalType{
-			Name: fType.src,
+			Name: name,
 		},
 	}
 
@@ -38,10 +44,12 @@ func (pr *Parser) parseDeclUnn(lex *Lexer) *TypeUnion {
 		return nil
 	}
 
+	frags := []Fragment{fDeclKeyword, fType}
+	if fTypeParams != nil {
+		frags = append(frags, fTypeParams)
+	}
 	newUnion.Src = NewConstruct(lex, FragDeclUnn,
-		fDeclKeyword,
-		fType,
-		fOpts,
+		append(frags, fOpts)...,
 	)
 
 	// Define the type
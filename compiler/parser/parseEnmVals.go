@@ -22,7 +22,25 @@ func (pr *Parser) parseEnmVals(
 	// Parse values
 SCAN_LOOP:
 	for {
-		tk, err := lex.NextSkip(Skip{FragTkSpace})
+		// Parse the documentation of the value (if any)
+		fDoc, doc, parsed := pr.parseOptDoc(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDoc != nil {
+			frags = append(frags, fDoc)
+		}
+
+		// Parse the annotations of the value (if any)
+		fAnnots, annotations, parsed := pr.parseOptAnnotations(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fAnnots != nil {
+			frags = append(frags, fAnnots)
+		}
+
+		tk, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil, nil
 		}
@@ -89,9 +107,11 @@ SCAN_LOOP:
 
 		// Add enum value
 		values = append(values, &EnumValue{
-			Src:  tk,
-			Name: value,
-			Enum: enum,
+			Src:         tk,
+			Name:        value,
+			Enum:        enum,
+			Doc:         doc,
+			Annotations: annotations,
 		})
 	}
 
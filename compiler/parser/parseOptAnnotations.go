@@ -0,0 +1,55 @@
+package parser
+
+// parseOptAnnotations parses an optional list of annotations
+// if there is any, returning the annotations
+func (pr *Parser) parseOptAnnotations(
+	lex *Lexer,
+) (Fragment, []*Annotation, bool) {
+	frags := []Fragment{}
+	annotations := []*Annotation{}
+
+	for {
+		// Peek for 1 token to find out whether an annotation begins
+		next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil, false
+		}
+		if next == nil || next.id != FragTkAnnot {
+			break
+		}
+
+		newAnnotation := pr.parseAnnotation(lex)
+		if newAnnotation == nil {
+			return nil, nil, false
+		}
+		frags = append(frags, newAnnotation.Src)
+		annotations = append(annotations, newAnnotation)
+	}
+
+	if len(frags) < 1 {
+		// No annotations
+		return nil, nil, true
+	}
+
+	// Make sure the annotations are followed by a declaration
+	next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil, nil, false
+	}
+	if next == nil ||
+		next.id == FragTkBlkEnd ||
+		next.id == FragTkParEnd {
+		pr.err(&pErr{
+			at:      frags[0].Begin(),
+			code:    ErrSyntax,
+			message: "annotation isn't followed by a declaration",
+		})
+		return nil, nil, false
+	}
+
+	if !pr.checkAnnotations(annotations) {
+		return nil, nil, false
+	}
+
+	return NewConstruct(lex, FragAnnots, frags...), annotations, true
+}
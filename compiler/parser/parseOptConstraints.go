@@ -0,0 +1,104 @@
+package parser
+
+// isConstraintsNext returns true if the lexer is positioned
+// at the beginning of a list of constraints, otherwise returns false.
+// The 'where' keyword must be followed by a constraint name,
+// which differs it from a field named where
+func isConstraintsNext(lex *Lexer) bool {
+	peeker := lex.New()
+	tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	if err != nil || tk == nil ||
+		tk.id != FragTkLatinAlphanum ||
+		tk.src != KeywordWhere {
+		return false
+	}
+	tk, err = peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	return err == nil && tk != nil &&
+		tk.id == FragTkLatinAlphanum &&
+		lowerCamelCase(tk.src) == nil
+}
+
+// isConstraintAt returns true if the given peeker is positioned
+// at the beginning of a constraint rather than at the beginning
+// of a field or parameter of the same name, otherwise returns false
+func isConstraintAt(peeker *Lexer) bool {
+	tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	if err != nil || tk == nil || tk.id != FragTkLatinAlphanum {
+		return false
+	}
+	kind := constraintKindByName(tk.src)
+	if kind == 0 {
+		return false
+	}
+	next, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	if err != nil {
+		return false
+	}
+	if kind != ConstraintNonEmpty {
+		return next != nil && next.id == FragTkPar
+	}
+	// A field or parameter is followed by its type
+	if next == nil {
+		return true
+	}
+	switch next.id {
+	case FragTkSymOpt, FragTkSymList, FragTkSymMap:
+		return false
+	case FragTkLatinAlphanum:
+		return !isUpLatinLetter(next.src[0])
+	}
+	return true
+}
+
+// parseOptConstraints parses an optional comma-separated list
+// of value constraints if there is any, returning the constraints
+func (pr *Parser) parseOptConstraints(
+	lex *Lexer,
+) (Fragment, []*Constraint, bool) {
+	if !isConstraintsNext(lex) {
+		// No constraints
+		return nil, nil, true
+	}
+
+	// Read keyword
+	fKeyword, err := readWordExact(
+		lex,
+		KeywordWhere,
+		FragTkKwdWhere,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil, nil, false
+	}
+
+	frags := []Fragment{fKeyword}
+	constraints := []*Constraint{}
+
+	for {
+		newConstraint := pr.parseConstraint(lex)
+		if newConstraint == nil {
+			return nil, nil, false
+		}
+		frags = append(frags, newConstraint.Src)
+		constraints = append(constraints, newConstraint)
+
+		// Continue if the constraint is followed by a separator
+		// and another constraint
+		peeker := lex.New()
+		sepTk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil, false
+		}
+		if sepTk == nil || sepTk.id != FragTkSymSep || !isConstraintAt(peeker) {
+			// The separator belongs to the enclosing list
+			break
+		}
+		separator, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil, false
+		}
+		frags = append(frags, separator)
+	}
+
+	return NewConstruct(lex, FragConstrs, frags...), constraints, true
+}
@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// isDeprecationNext returns true if the lexer is positioned
+// at the beginning of a deprecation marker, otherwise returns false.
+// A marker begins with the 'deprecated' keyword followed by a parenthesis
+// and a string literal, which differs it from a property named deprecated
+func isDeprecationNext(lex *Lexer) bool {
+	peeker := lex.New()
+	tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	if err != nil || tk == nil ||
+		tk.id != FragTkLatinAlphanum ||
+		tk.src != KeywordDeprecated {
+		return false
+	}
+	tk, err = peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	if err != nil || tk == nil || tk.id != FragTkPar {
+		return false
+	}
+	tk, err = peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+	return err == nil && tk != nil && tk.id == FragTkStr
+}
+
+// parseOptDeprecation parses an optional deprecation marker
+// if there is any, returning the deprecation
+func (pr *Parser) parseOptDeprecation(
+	lex *Lexer,
+) (Fragment, *Deprecation, bool) {
+	if !isDeprecationNext(lex) {
+		// No deprecation marker
+		return nil, nil, true
+	}
+
+	// Read keyword
+	fKeyword, err := readWordExact(
+		lex,
+		KeywordDeprecated,
+		FragTkKwdDepr,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil, nil, false
+	}
+
+	// Read '('
+	fOpening, err := readToken(lex, FragTkPar, "deprecation opening '('")
+	if pr.err(err) {
+		return nil, nil, false
+	}
+
+	frags := []Fragment{fKeyword, fOpening}
+	strs := []string{}
+
+	// Read the reason and the optional replacement
+	for {
+		tk, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil, false
+		}
+		if tk == nil {
+			pr.err(&pErr{
+				at:      lex.Cursor(),
+				code:    ErrSyntax,
+				message: "unexpected end of file",
+			})
+			return nil, nil, false
+		}
+
+		if tk.id == FragTkParEnd && len(strs) > 0 {
+			frags = append(frags, tk)
+			break
+		}
+		if tk.id != FragTkStr || len(strs) > 1 {
+			pr.err(&pErr{
+				at:   tk.begin,
+				code: ErrSyntax,
+				message: fmt.Sprintf(
+					"unexpected token '%s', expected "+
+						"deprecation reason and optional replacement",
+					tk.src,
+				),
+			})
+			return nil, nil, false
+		}
+
+		str, unqErr := strconv.Unquote(tk.src)
+		if unqErr != nil {
+			pr.err(&pErr{
+				at:   tk.begin,
+				code: ErrSyntax,
+				message: fmt.Sprintf(
+					"illegal string literal %s (%s)",
+					tk.src,
+					unqErr,
+				),
+			})
+			return nil, nil, false
+		}
+		if len(strs) < 1 && str == "" {
+			pr.err(&pErr{
+				at:      tk.begin,
+				code:    ErrSyntax,
+				message: "empty deprecation reason",
+			})
+			return nil, nil, false
+		}
+		frags = append(frags, tk)
+		strs = append(strs, str)
+
+		// Read the separator unless the list ends
+		sepTk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil, false
+		}
+		if sepTk != nil && sepTk.id == FragTkParEnd {
+			continue
+		}
+		separator, err := readToken(
+			lex,
+			FragTkSymSep,
+			"deprecation separator ','",
+		)
+		if pr.err(err) {
+			return nil, nil, false
+		}
+		frags = append(frags, separator)
+	}
+
+	frag := NewConstruct(lex, FragDepr, frags...)
+	deprecation := &Deprecation{
+		Src:    frag,
+		Reason: strs[0],
+	}
+	if len(strs) > 1 {
+		deprecation.Replacement = strs[1]
+	}
+
+	// Make sure the deprecation is followed by a declaration
+	next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil, nil, false
+	}
+	if next == nil ||
+		next.id == FragTkBlkEnd ||
+		next.id == FragTkParEnd {
+		pr.err(&pErr{
+			at:      frag.Begin(),
+			code:    ErrSyntax,
+			message: "deprecation isn't followed by a declaration",
+		})
+		return nil, nil, false
+	}
+
+	return frag, deprecation, true
+}
@@ -0,0 +1,74 @@
+package parser
+
+import "strings"
+
+// parseOptDoc parses an optional block of consecutive documentation lines
+// if there is any, returning the documentation text
+func (pr *Parser) parseOptDoc(lex *Lexer) (Fragment, string, bool) {
+	frags := []Fragment{}
+	lines := []string{}
+
+	for {
+		// Peek for 1 token to find out whether a documentation line begins
+		next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, "", false
+		}
+		if next == nil || next.id != FragTkDocLineInit {
+			break
+		}
+
+		// Read '#'
+		fInit, err := readToken(
+			lex,
+			FragTkDocLineInit,
+			"documentation line initiator '#'",
+		)
+		if pr.err(err) {
+			return nil, "", false
+		}
+		frags = append(frags, fInit)
+
+		// Read the text of the line (if any)
+		txt, err := lex.New().Next()
+		if pr.err(err) {
+			return nil, "", false
+		}
+		if txt == nil || txt.id != FragTkDocLineTxt {
+			// Empty documentation line
+			lines = append(lines, "")
+			continue
+		}
+		_, _ = lex.Next()
+		frags = append(frags, txt)
+		lines = append(lines, strings.TrimRight(
+			strings.TrimPrefix(txt.src, " "),
+			" \t",
+		))
+	}
+
+	if len(frags) < 1 {
+		// No documentation
+		return nil, "", true
+	}
+
+	// Make sure the documentation is followed by a documentable declaration
+	next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil, "", false
+	}
+	if next == nil ||
+		next.id == FragTkBlkEnd ||
+		next.id == FragTkParEnd {
+		pr.err(&pErr{
+			at:      frags[0].Begin(),
+			code:    ErrSyntax,
+			message: "documentation isn't followed by a declaration",
+		})
+		return nil, "", false
+	}
+
+	return NewConstruct(lex, FragDoc, frags...),
+		strings.Join(lines, "\n"),
+		true
+}
@@ -0,0 +1,144 @@
+package parser
+
+import "fmt"
+
+// parseOptImpls parses an optional list of implemented traits if there is any.
+// onResolved is called with the implementing type once all referenced trait
+// types are resolved
+func (pr *Parser) parseOptImpls(
+	lex *Lexer,
+	implementation Type,
+	onResolved func([]*TypeTrait),
+) (Fragment, bool) {
+	// Peek for 1 token to find out whether there is an implements clause
+	next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil, false
+	}
+	if next == nil ||
+		next.id != FragTkLatinAlphanum ||
+		next.src != KeywordImplements {
+		// Not an implements clause, no traits
+		return nil, true
+	}
+
+	// Read keyword
+	fKeyword, err := readWordExact(
+		lex,
+		KeywordImplements,
+		FragTkKwdImpl,
+		"keyword",
+	)
+	if pr.err(err) {
+		return nil, false
+	}
+
+	frags := []Fragment{fKeyword}
+	traitNames := []*Token{}
+	byName := map[string]*Token{}
+
+	// Read the comma-separated list of trait type identifiers
+	for {
+		fTrait, err := readWord(
+			lex,
+			"trait type identifier",
+			FragTkIdnType,
+			capitalizedCamelCase,
+		)
+		if pr.err(err) {
+			return nil, false
+		}
+		frags = append(frags, fTrait)
+
+		// Check for redundant traits
+		if defined, isDefined := byName[fTrait.src]; isDefined {
+			pr.err(&pErr{
+				at:   fTrait.begin,
+				code: ErrTraitImplRedund,
+				message: fmt.Sprintf(
+					"Redundant implementation of trait %s "+
+						"(previously declared at %s)",
+					fTrait.src,
+					defined.begin,
+				),
+			})
+			return nil, false
+		}
+		byName[fTrait.src] = fTrait
+		traitNames = append(traitNames, fTrait)
+
+		// Continue if there's a separator
+		sepTk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, false
+		}
+		if sepTk == nil || sepTk.id != FragTkSymSep {
+			break
+		}
+		separator, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, false
+		}
+		frags = append(frags, separator)
+	}
+
+	env := pr.env
+	pr.deferJob(func() {
+		traits := make([]*TypeTrait, 0, len(traitNames))
+		for _, tk := range traitNames {
+			// Make sure the trait type is defined
+			t := pr.findTypeByDesignation(tk.src)
+			if t == nil {
+				if pr.isFailedTypeDecl(tk.src) {
+					// The declaration of the trait failed
+					// and was already reported
+					continue
+				}
+				pr.err(&pErr{
+					at:   tk.begin,
+					code: ErrTypeUndef,
+					message: fmt.Sprintf(
+						"trait type %s is undefined",
+						tk.src,
+					),
+				})
+				continue
+			}
+
+			// Make sure the type is a trait
+			trait, isTrait := t.(*TypeTrait)
+			if !isTrait {
+				pr.err(&pErr{
+					at:   tk.begin,
+					code: ErrTraitImplNonTrait,
+					message: fmt.Sprintf(
+						"%s implements non-trait type %s",
+						implementation,
+						t,
+					),
+				})
+				continue
+			}
+
+			if env.isTemplate() {
+				// Templates don't implement traits, their instances do
+				continue
+			}
+
+			traits = append(traits, trait)
+			trait.Implementations = append(
+				trait.Implementations,
+				implementation,
+			)
+
+			// Make sure the implementation is complete
+			// after all other types are resolved
+			pr.deferJob(func() {
+				pr.checkTraitImpl(tk, implementation, trait)
+			})
+		}
+		onResolved(traits)
+	})
+
+	return NewConstruct(lex, FragImpls, frags...), true
+}
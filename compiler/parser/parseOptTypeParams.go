@@ -0,0 +1,157 @@
+package parser
+
+import "fmt"
+
+// parseOptTypeParams parses an optional list of type parameters following
+// the identifier of a type declaration if there is any.
+// Returns the name the declared type is to be registered under, which is
+// the name of the instance when a generic type is being instantiated.
+// A generic type declaration that's not being instantiated is parsed
+// as a template up until the end of the declaration
+func (pr *Parser) parseOptTypeParams(
+	lex *Lexer,
+	fName *Token,
+) (Fragment, string, bool) {
+	// Peek for 1 token to find out whether there are type parameters
+	next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil, "", false
+	}
+	if next == nil || next.id != FragTkGen {
+		// Not a generic type
+		return nil, fName.src, true
+	}
+
+	// Read '<'
+	fBegin, err := readToken(
+		lex,
+		FragTkGen,
+		"type parameter list opening '<'",
+	)
+	if pr.err(err) {
+		return nil, "", false
+	}
+
+	frags := []Fragment{fBegin}
+	params := []*Token{}
+	byName := map[string]*Token{}
+
+	// Read the comma-separated list of type parameter identifiers
+	for {
+		fParam, err := readWord(
+			lex,
+			"type parameter identifier",
+			FragTkIdnTypeParam,
+			capitalizedCamelCase,
+		)
+		if pr.err(err) {
+			return nil, "", false
+		}
+		frags = append(frags, fParam)
+
+		// Check for redeclarations
+		if defined, isDefined := byName[fParam.src]; isDefined {
+			pr.err(&pErr{
+				at:   fParam.begin,
+				code: ErrTypeParamRedecl,
+				message: fmt.Sprintf(
+					"Redeclaration of type parameter %s "+
+						"(previously declared at %s)",
+					fParam.src,
+					defined.begin,
+				),
+			})
+			return nil, "", false
+		}
+
+		// Make sure primitive types aren't shadowed
+		if stdTypeByName(fParam.src) != nil {
+			pr.err(&pErr{
+				at:   fParam.begin,
+				code: ErrTypeParamRedecl,
+				message: fmt.Sprintf(
+					"Type parameter %s collides with "+
+						"the reserved primitive type %s",
+					fParam.src,
+					fParam.src,
+				),
+			})
+			return nil, "", false
+		}
+		byName[fParam.src] = fParam
+		params = append(params, fParam)
+
+		// Read either a separator or the end of the list
+		tk, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, "", false
+		}
+		if tk == nil {
+			// Unexpected EOF
+			pr.err(&pErr{
+				at:      lex.Cursor(),
+				code:    ErrSyntax,
+				message: "unexpected end of file",
+			})
+			return nil, "", false
+		}
+		frags = append(frags, tk)
+		if tk.id == FragTkGenEnd {
+			break
+		}
+		if tk.id != FragTkSymSep {
+			pr.err(&pErr{
+				at:      tk.begin,
+				code:    ErrSyntax,
+				message: fmt.Sprintf("unexpected token '%s'", tk.src),
+			})
+			return nil, "", false
+		}
+	}
+
+	frag := NewConstruct(lex, FragTypeParams, frags...)
+
+	if pr.env != nil && pr.env.instance != "" {
+		// The generic type is being instantiated
+		return frag, pr.env.instance, true
+	}
+
+	// Parse the rest of the declaration as a template
+	args := make(map[string]Type, len(params))
+	for _, param := range params {
+		args[param.src] = nil
+	}
+
+	// Make sure declared types aren't shadowed
+	// after all declarations are registered
+	pr.deferJob(func() {
+		for _, param := range params {
+			var declaredAt Fragment
+			if t, isDeclared := pr.typeByName[param.src]; isDeclared {
+				declaredAt = t.Source()
+			} else if g, isDeclared := pr.genericByName[param.src]; isDeclared {
+				declaredAt = g.src
+			} else {
+				continue
+			}
+			pr.err(&pErr{
+				at:   param.begin,
+				code: ErrTypeParamRedecl,
+				message: fmt.Sprintf(
+					"Type parameter %s collides with "+
+						"type %s (declared at %s)",
+					param.src,
+					param.src,
+					declaredAt.Begin(),
+				),
+			})
+		}
+	})
+	pr.env = &typeEnv{
+		name:   fName.src,
+		params: params,
+		args:   args,
+	}
+
+	return frag, fName.src, true
+}
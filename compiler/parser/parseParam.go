@@ -24,11 +24,41 @@ func (pr *Parser) parseParam(lex *Lexer, target GraphNode) *Parameter {
 	// Read type and set it when it's determined
 	fType := pr.parseTypeDesig(lex, func(t Type) {
 		// Make sure the type of the parameter is pure
-		if !t.IsPure() {
-			pr.err(&pErr{
-				at:      fName.begin,
-				code:    ErrParamImpure,
-				message: fmt.Sprintf("parameter of impure type %s", t),
+		// after all other types are resolved
+		pr.deferJob(func() {
+			if !t.IsPure() {
+				pr.err(&pErr{
+					at:      fName.begin,
+					code:    ErrParamImpure,
+					message: fmt.Sprintf("parameter of impure type %s", t),
+				})
+			}
+		})
+
+		// Make sure the default value matches the type of the parameter
+		// after all other types are resolved
+		if newParam.Default != nil {
+			pr.deferJob(func() {
+				mismatch := matchValue(newParam.Default, t)
+				if mismatch != "" {
+					pr.err(&pErr{
+						at:   newParam.Default.Src.Begin(),
+						code: ErrParamDefaultMismatch,
+						message: fmt.Sprintf(
+							"mismatching default value of parameter %s (%s)",
+							newParam.Name,
+							mismatch,
+						),
+					})
+				}
+			})
+		}
+
+		// Make sure the constraints are applicable to the type of the
+		// parameter after all other types are resolved
+		if len(newParam.Constraints) > 0 {
+			pr.deferJob(func() {
+				pr.checkConstraints(newParam.Constraints, t)
 			})
 		}
 
@@ -38,10 +68,36 @@ func (pr *Parser) parseParam(lex *Lexer, target GraphNode) *Parameter {
 		return nil
 	}
 
-	newParam.Src = NewConstruct(lex, FragParam,
-		fName,
-		fType,
-	)
+	frags := []Fragment{fName, fType}
+
+	// Parse the constraints if any
+	fConstrs, constraints, parsed := pr.parseOptConstraints(lex)
+	if !parsed {
+		return nil
+	}
+	if fConstrs != nil {
+		newParam.Constraints = constraints
+		frags = append(frags, fConstrs)
+	}
+
+	// Parse the default value if any
+	tk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil
+	}
+	if tk != nil && tk.id == FragTkSymEq {
+		fEq, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil
+		}
+		newParam.Default = pr.parseValue(lex)
+		if newParam.Default == nil {
+			return nil
+		}
+		frags = append(frags, fEq, newParam.Default.Src)
+	}
+
+	newParam.Src = NewConstruct(lex, FragParam, frags...)
 
 	// Define the graph node
 	pr.onParameter(newParam)
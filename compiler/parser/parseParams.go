@@ -26,10 +26,28 @@ func (pr *Parser) parseParams(
 	// Parse parameters
 SCAN_LOOP:
 	for {
+		// Parse the documentation of the parameter (if any)
+		fDoc, doc, parsed := pr.parseOptDoc(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDoc != nil {
+			frags = append(frags, fDoc)
+		}
+
+		// Parse the annotations of the parameter (if any)
+		fAnnots, annotations, parsed := pr.parseOptAnnotations(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fAnnots != nil {
+			frags = append(frags, fAnnots)
+		}
+
 		peeker := lex.New()
 		// Peek for 1 token to find out whether
 		// the block ended or a new parameter began
-		tk, err := peeker.NextSkip(Skip{FragTkSpace})
+		tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil, nil
 		}
@@ -50,12 +68,14 @@ SCAN_LOOP:
 			if newParam == nil {
 				return nil, nil
 			}
+			newParam.Doc = doc
+			newParam.Annotations = annotations
 			frags = append(frags, newParam.Src)
 			params = append(params, newParam)
 		case FragTkParEnd:
 			// End of the block
 			frags = append(frags, tk)
-			_, _ = lex.NextSkip(Skip{FragTkSpace})
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 			break SCAN_LOOP
 		default:
 			// Unexpected token
@@ -87,12 +107,12 @@ SCAN_LOOP:
 		byName[paramName] = tk
 
 		// Skip separator if any
-		sepTk, err := lex.New().NextSkip(Skip{FragTkSpace})
+		sepTk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil, nil
 		}
-		if sepTk.id == FragTkSymSep {
-			separator, err := lex.NextSkip(Skip{FragTkSpace})
+		if sepTk != nil && sepTk.id == FragTkSymSep {
+			separator, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 			if pr.err(err) {
 				return nil, nil
 			}
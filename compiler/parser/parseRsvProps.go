@@ -24,10 +24,37 @@ func (pr *Parser) parseRsvProps(
 	// Parse properties
 SCAN_LOOP:
 	for {
+		// Parse the documentation of the property (if any)
+		fDoc, doc, parsed := pr.parseOptDoc(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDoc != nil {
+			frags = append(frags, fDoc)
+		}
+
+		// Parse the deprecation marker of the property (if any)
+		fDepr, depr, parsed := pr.parseOptDeprecation(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDepr != nil {
+			frags = append(frags, fDepr)
+		}
+
+		// Parse the annotations of the property (if any)
+		fAnnots, annotations, parsed := pr.parseOptAnnotations(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fAnnots != nil {
+			frags = append(frags, fAnnots)
+		}
+
 		peeker := lex.New()
 		// Peek for 1 token to find out whether
 		// the block ended or a new property began
-		tk, err := peeker.NextSkip(Skip{FragTkSpace})
+		tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil, nil
 		}
@@ -48,12 +75,15 @@ SCAN_LOOP:
 			if newProp == nil {
 				return nil, nil
 			}
+			newProp.Doc = doc
+			newProp.Deprecated = depr
+			newProp.Annotations = annotations
 			frags = append(frags, newProp.Src)
 			props = append(props, newProp)
 		case FragTkBlkEnd:
 			// End of the block
 			frags = append(frags, tk)
-			_, _ = lex.NextSkip(Skip{FragTkSpace})
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 			break SCAN_LOOP
 		default:
 			// Unexpected token
@@ -9,82 +9,189 @@ func (pr *Parser) parseScmFile(lex *Lexer) Fragment {
 		return nil
 	}
 
-	pr.mod.SchemaName = schemaName
+	if pr.mod.SchemaName == "" {
+		pr.mod.SchemaName = schemaName
+	} else if schemaName != pr.mod.SchemaName {
+		// Imported files must belong to the same schema
+		pr.err(&pErr{
+			at:   fDeclScm.Begin(),
+			code: ErrImportSchemaMismatch,
+			message: fmt.Sprintf(
+				"imported file declares schema %s instead of %s",
+				schemaName,
+				pr.mod.SchemaName,
+			),
+		})
+		return nil
+	}
 
 	frags := []Fragment{fDeclScm}
 
 	// Read declarations by peeking for 1 token
 	for {
-		tk, err := lex.New().NextSkip(Skip{FragTkSpace})
-		if pr.err(err) {
+		if pr.errLimitReached() {
 			return nil
 		}
+
+		// Parse the documentation of the declaration (if any)
+		fDoc, doc, parsed := pr.parseOptDoc(lex)
+		if !parsed {
+			pr.declFailed = true
+			pr.resync(lex, nil)
+			continue
+		}
+		if fDoc != nil {
+			frags = append(frags, fDoc)
+		}
+
+		// Parse the deprecation marker of the declaration (if any)
+		fDepr, depr, parsed := pr.parseOptDeprecation(lex)
+		if !parsed {
+			pr.declFailed = true
+			pr.resync(lex, nil)
+			continue
+		}
+		if fDepr != nil {
+			frags = append(frags, fDepr)
+		}
+
+		// Parse the annotations of the declaration (if any)
+		fAnnots, annotations, parsed := pr.parseOptAnnotations(lex)
+		if !parsed {
+			pr.declFailed = true
+			pr.resync(lex, nil)
+			continue
+		}
+		if fAnnots != nil {
+			frags = append(frags, fAnnots)
+		}
+
+		tk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			pr.declFailed = true
+			pr.resync(lex, nil)
+			continue
+		}
 		if tk == nil {
 			break
 		}
 
 		frags = append(frags, tk)
 
+		// Remember the deferred jobs preceding the declaration
+		// to be able to drop the ones of a failed declaration
+		// keeping only the checks of its type references
+		jobs := len(pr.deferredJobs)
+		typeRefChecks := len(pr.typeRefChecks)
+
+		// Remember the beginning of the declaration
+		// to be able to instantiate generic types
+		declLex := lex.New()
+
 		var frag Fragment
 		switch tk.id {
 		case FragTkLatinAlphanum:
 			// A keyword?
 			switch tk.src {
+			case KeywordImport:
+				// Import declaration
+				if depr != nil {
+					pr.err(&pErr{
+						at:      depr.Src.Begin(),
+						code:    ErrSyntax,
+						message: "import declarations can't be deprecated",
+					})
+					break
+				}
+				if fAnnots != nil {
+					pr.err(&pErr{
+						at:      fAnnots.Begin(),
+						code:    ErrSyntax,
+						message: "import declarations can't be annotated",
+					})
+					break
+				}
+				frag = pr.parseDeclImp(lex)
 			case KeywordAlias:
 				// Alias type declaration
 				if f := pr.parseDeclAls(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
+					frag = f.Src
+				}
+			case KeywordScalar:
+				// Scalar type declaration
+				if f := pr.parseDeclScl(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordEnum:
 				// Enum type declaration
 				if f := pr.parseDeclEnm(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordUnion:
 				// Union type declaration
 				if f := pr.parseDeclUnn(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordStruct:
 				// Struct type declaration
 				if f := pr.parseDeclStr(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordResolver:
 				// Resolver type declaration
 				if f := pr.parseDeclRsv(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordTrait:
 				// Trait type declaration
-				panic("trait types are not yet implemented")
+				if f := pr.parseDeclTrt(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
+					frag = f.Src
+				}
 			case KeywordQuery:
 				// Query endpoint declaration
 				if f := pr.parseDeclQry(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordMutation:
 				// Mutation endpoint declaration
 				if f := pr.parseDeclMut(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
 					frag = f.Src
-				} else {
-					return nil
 				}
 			case KeywordSubscription:
 				// Subscription endpoint declaration
-				panic("subscriptions are not yet implemented")
+				if f := pr.parseDeclSub(lex); f != nil {
+					f.Doc = doc
+					f.Deprecated = depr
+					f.Annotations = annotations
+					frag = f.Src
+				}
 			default:
 				pr.err(&pErr{
 					at:   tk.begin,
@@ -94,11 +201,47 @@ func (pr *Parser) parseScmFile(lex *Lexer) Fragment {
 						tk.src,
 					),
 				})
-				return nil
 			}
-			frags = append(frags, frag)
+		default:
+			pr.err(&pErr{
+				at:   tk.begin,
+				code: ErrSyntax,
+				message: fmt.Sprintf(
+					"unexpected token '%s', expected a declaration",
+					tk.src,
+				),
+			})
+		}
+
+		if frag == nil {
+			// Skip the failed declaration and continue parsing
+			pr.env = nil
+			pr.declFailed = true
+			pr.deferredJobs = append(
+				pr.deferredJobs[:jobs],
+				pr.typeRefChecks[typeRefChecks:]...,
+			)
+			pr.typeRefChecks = pr.typeRefChecks[:typeRefChecks]
+			pr.resync(lex, tk)
+			continue
+		}
+		pr.typeRefChecks = pr.typeRefChecks[:typeRefChecks]
+		if pr.env.isTemplate() {
+			// Define the generic type
+			pr.onGenericDecl(
+				tk.src,
+				declLex,
+				frag,
+				doc,
+				depr,
+				annotations,
+			)
+			pr.env = nil
 		}
+		frags = append(frags, frag)
 	}
 
-	return NewConstruct(lex, FragScmFile, frags...)
+	fFile := NewConstruct(lex, FragScmFile, scanComments(lex, frags)...)
+	pr.fileFrags = append(pr.fileFrags, fFile)
+	return fFile
 }
@@ -31,11 +31,25 @@ func (pr *Parser) parseStrField(
 		}
 
 		// Make sure the type of the field is pure
-		if !t.IsPure() {
-			pr.err(&pErr{
-				at:      fName.begin,
-				code:    ErrStructFieldImpure,
-				message: fmt.Sprintf("struct field of impure type %s", t),
+		// after all other types are resolved
+		pr.deferJob(func() {
+			if !t.IsPure() {
+				pr.err(&pErr{
+					at:   fName.begin,
+					code: ErrStructFieldImpure,
+					message: fmt.Sprintf(
+						"struct field of impure type %s",
+						t,
+					),
+				})
+			}
+		})
+
+		// Make sure the constraints are applicable to the type of the field
+		// after all other types are resolved
+		if len(newField.Constraints) > 0 {
+			pr.deferJob(func() {
+				pr.checkConstraints(newField.Constraints, t)
 			})
 		}
 
@@ -45,10 +59,19 @@ func (pr *Parser) parseStrField(
 		return nil
 	}
 
-	newField.Src = NewConstruct(lex, FragStrField,
-		fName,
-		fType,
-	)
+	frags := []Fragment{fName, fType}
+
+	// Parse the constraints if any
+	fConstrs, constraints, parsed := pr.parseOptConstraints(lex)
+	if !parsed {
+		return nil
+	}
+	if fConstrs != nil {
+		newField.Constraints = constraints
+		frags = append(frags, fConstrs)
+	}
+
+	newField.Src = NewConstruct(lex, FragStrField, frags...)
 
 	// Define the graph node
 	if !pr.onGraphNode(newField) {
@@ -24,10 +24,37 @@ func (pr *Parser) parseStrFields(
 	// Parse fields
 SCAN_LOOP:
 	for {
+		// Parse the documentation of the field (if any)
+		fDoc, doc, parsed := pr.parseOptDoc(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDoc != nil {
+			frags = append(frags, fDoc)
+		}
+
+		// Parse the deprecation marker of the field (if any)
+		fDepr, depr, parsed := pr.parseOptDeprecation(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDepr != nil {
+			frags = append(frags, fDepr)
+		}
+
+		// Parse the annotations of the field (if any)
+		fAnnots, annotations, parsed := pr.parseOptAnnotations(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fAnnots != nil {
+			frags = append(frags, fAnnots)
+		}
+
 		peeker := lex.New()
 		// Peek for 1 token to find out whether
 		// the block ended or a new field began
-		tk, err := peeker.NextSkip(Skip{FragTkSpace})
+		tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil, nil
 		}
@@ -48,12 +75,15 @@ SCAN_LOOP:
 			if newField == nil {
 				return nil, nil
 			}
+			newField.Doc = doc
+			newField.Deprecated = depr
+			newField.Annotations = annotations
 			frags = append(frags, newField.Src)
 			fields = append(fields, newField)
 		case FragTkBlkEnd:
 			// End of the block
 			frags = append(frags, tk)
-			_, _ = lex.NextSkip(Skip{FragTkSpace})
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 			break SCAN_LOOP
 		default:
 			// Unexpected token
@@ -0,0 +1,132 @@
+package parser
+
+import "fmt"
+
+// parseTrtProps parses the properties block of a trait declaration
+func (pr *Parser) parseTrtProps(
+	lex *Lexer,
+	trait *TypeTrait,
+) (Fragment, []*TraitProperty) {
+	// Read '{'
+	fBlockBegin, err := readToken(
+		lex,
+		FragTkBlk,
+		"trait properties block opening '{'",
+	)
+	if pr.err(err) {
+		return nil, nil
+	}
+
+	frags := []Fragment{fBlockBegin}
+	byName := map[string]*Token{}
+	props := []*TraitProperty{}
+
+	// Parse properties
+SCAN_LOOP:
+	for {
+		// Parse the documentation of the property (if any)
+		fDoc, doc, parsed := pr.parseOptDoc(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDoc != nil {
+			frags = append(frags, fDoc)
+		}
+
+		// Parse the deprecation marker of the property (if any)
+		fDepr, depr, parsed := pr.parseOptDeprecation(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fDepr != nil {
+			frags = append(frags, fDepr)
+		}
+
+		// Parse the annotations of the property (if any)
+		fAnnots, annotations, parsed := pr.parseOptAnnotations(lex)
+		if !parsed {
+			return nil, nil
+		}
+		if fAnnots != nil {
+			frags = append(frags, fAnnots)
+		}
+
+		peeker := lex.New()
+		// Peek for 1 token to find out whether
+		// the block ended or a new property began
+		tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil
+		}
+		if tk == nil {
+			// Unexpected EOF
+			pr.err(&pErr{
+				at:      peeker.Cursor(),
+				code:    ErrSyntax,
+				message: "unexpected end of file",
+			})
+			return nil, nil
+		}
+
+		switch tk.id {
+		case FragTkLatinAlphanum:
+			// A property
+			newProp := pr.parseTrtProp(lex, trait)
+			if newProp == nil {
+				return nil, nil
+			}
+			newProp.Doc = doc
+			newProp.Deprecated = depr
+			newProp.Annotations = annotations
+			frags = append(frags, newProp.Src)
+			props = append(props, newProp)
+		case FragTkBlkEnd:
+			// End of the block
+			frags = append(frags, tk)
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+			break SCAN_LOOP
+		default:
+			// Unexpected token
+			pr.err(&pErr{
+				at:      tk.begin,
+				code:    ErrSyntax,
+				message: fmt.Sprintf("unexpected token '%s'", tk.src),
+			})
+			return nil, nil
+		}
+
+		propName := tk.src
+
+		// Check for redeclarations
+		if defined, isDefined := byName[propName]; isDefined {
+			pr.err(&pErr{
+				at:   tk.begin,
+				code: ErrTraitPropRedecl,
+				message: fmt.Sprintf(
+					"Redeclaration of trait property %s "+
+						"(previously declared at %s)",
+					propName,
+					defined.begin,
+				),
+			})
+			return nil, nil
+		}
+
+		byName[propName] = tk
+	}
+
+	// Make sure there's at least 1 property
+	if len(props) < 1 {
+		pr.err(&pErr{
+			at:   fBlockBegin.begin,
+			code: ErrTraitNoProps,
+			message: fmt.Sprintf(
+				"trait %s is missing properties",
+				trait.Name,
+			),
+		})
+		return nil, nil
+	}
+
+	return NewConstruct(lex, FragTrtProps, frags...), props
+}
@@ -0,0 +1,64 @@
+package parser
+
+import "fmt"
+
+// parseTypeArgs parses the type argument list of a generic type reference.
+// The returned type arguments are set once they're resolved,
+// arguments that couldn't be resolved remain nil
+func (pr *Parser) parseTypeArgs(lex *Lexer) (Fragment, []Type) {
+	// Read '<'
+	fBegin, err := readToken(
+		lex,
+		FragTkGen,
+		"type argument list opening '<'",
+	)
+	if pr.err(err) {
+		return nil, nil
+	}
+
+	frags := []Fragment{fBegin}
+
+	// The arguments are resolved by deferred jobs writing into the
+	// final backing array, it mustn't be reallocated once parsing is done
+	args := []Type{}
+
+	// Read the comma-separated list of type arguments
+	for {
+		index := len(args)
+		args = append(args, nil)
+		fArg := pr.parseTypeDesig(lex, func(t Type) { args[index] = t })
+		if fArg == nil {
+			return nil, nil
+		}
+		frags = append(frags, fArg)
+
+		// Read either a separator or the end of the list
+		tk, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil, nil
+		}
+		if tk == nil {
+			// Unexpected EOF
+			pr.err(&pErr{
+				at:      lex.Cursor(),
+				code:    ErrSyntax,
+				message: "unexpected end of file",
+			})
+			return nil, nil
+		}
+		frags = append(frags, tk)
+		if tk.id == FragTkGenEnd {
+			break
+		}
+		if tk.id != FragTkSymSep {
+			pr.err(&pErr{
+				at:      tk.begin,
+				code:    ErrSyntax,
+				message: fmt.Sprintf("unexpected token '%s'", tk.src),
+			})
+			return nil, nil
+		}
+	}
+
+	return NewConstruct(lex, FragTypeArgs, frags...), args
+}
@@ -21,6 +21,8 @@ func (pr *Parser) parseTypeDesig(
 				v.StoreType = t
 			case *TypeList:
 				v.StoreType = t
+			case *TypeMap:
+				v.StoreType = t
 			}
 		}
 		previousTp = t
@@ -29,7 +31,7 @@ func (pr *Parser) parseTypeDesig(
 	// Parse chain
 SCAN_LOOP:
 	for {
-		tk, err := lex.NextSkip(Skip{FragTkSpace})
+		tk, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil
 		}
@@ -49,6 +51,50 @@ SCAN_LOOP:
 			appendTp(&TypeList{})
 			frags = append(frags, tk)
 
+		case FragTkSymMap:
+			// Map container type
+			newMap := &TypeMap{}
+			appendTp(newMap)
+			frags = append(frags, tk)
+
+			// Read the key type and set it when it's determined
+			var fKey Fragment
+			fKey = pr.parseTypeDesig(lex, func(t Type) {
+				// Make sure the key type is legal
+				// after all other types are resolved
+				pr.deferJob(func() {
+					if !isMapKeyType(t) {
+						pr.err(&pErr{
+							at:   fKey.Begin(),
+							code: ErrMapKeyIllegal,
+							message: fmt.Sprintf(
+								"illegal map key type %s "+
+									"(expected a primitive, a scalar, "+
+									"an enum or an alias of them)",
+								t,
+							),
+						})
+					}
+				})
+
+				newMap.KeyType = t
+			})
+			if fKey == nil {
+				return nil
+			}
+			frags = append(frags, fKey)
+
+			// Read ']'
+			fKeyEnd, err := readToken(
+				lex,
+				FragTkSymMapEnd,
+				"map key type closing ']'",
+			)
+			if pr.err(err) {
+				return nil
+			}
+			frags = append(frags, fKeyEnd)
+
 		case FragTkSymOpt:
 			// Optional container type
 			// Ensure the previous type in the chain was not also an optional
@@ -89,38 +135,76 @@ SCAN_LOOP:
 			tk.id = FragTkIdnType
 			frags = append(frags, tk)
 
+			// Parse the type arguments if there are any
+			var fArgs Fragment
+			var args []Type
+			next, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+			if pr.err(err) {
+				return nil
+			}
+			if next != nil && next.id == FragTkGen {
+				fArgs, args = pr.parseTypeArgs(lex)
+				if fArgs == nil {
+					return nil
+				}
+				frags = append(frags, fArgs)
+			}
+
+			pr.deferTypeRefCheck(tk)
+			env := pr.env
 			pr.deferJob(func() {
 				// Make sure the terminal type is defined
-				terminalType := pr.findTypeByDesignation(tk.src)
+				terminalType := pr.resolveTerminalType(tk, env, fArgs, args)
 				if terminalType == nil {
+					return
+				}
+
+				// Make sure type arguments don't form a chain of optionals
+				_, tailIsOpt := previousTp.(*TypeOptional)
+				if _, isOpt := terminalType.(*TypeOptional); isOpt && tailIsOpt {
 					pr.err(&pErr{
 						at:   tk.begin,
-						code: ErrTypeUndef,
+						code: ErrTypeOptChain,
 						message: fmt.Sprintf(
-							"terminal type %s is undefined",
-							tk.src,
+							"illegal chain of optionals " +
+								"(optional type of optional types)",
 						),
 					})
 					return
 				}
 				appendTp(terminalType)
 
+				// Type arguments can be anonymous types themselves
+				terminal := terminalType
+				if t := terminalType.TerminalType(); t != nil {
+					terminal = t
+				}
+
 				// Reference the terminal type in the type chain
 				for t := tp; t != nil; {
 					if v, isOpt := t.(*TypeOptional); isOpt {
-						v.Terminal = previousTp
+						v.Terminal = terminal
 						t = v.StoreType
 						continue
 					}
 					if v, isList := t.(*TypeList); isList {
-						v.Terminal = previousTp
+						v.Terminal = terminal
+						t = v.StoreType
+						continue
+					}
+					if v, isMap := t.(*TypeMap); isMap {
+						if v.KeyType == nil {
+							// Unresolved key types are reported elsewhere
+							return
+						}
+						v.Terminal = terminal
 						t = v.StoreType
 						continue
 					}
 					break
 				}
 
-				_, terminalIsNone := previousTp.(TypeStdNone)
+				_, terminalIsNone := terminal.(TypeStdNone)
 				if _, isNone := tp.(TypeStdNone); !isNone && terminalIsNone {
 					pr.err(&pErr{
 						at:      frags[0].Begin(),
@@ -129,9 +213,7 @@ SCAN_LOOP:
 					})
 				}
 
-				if tp.TerminalType() != nil {
-					tp = pr.onAnonymousType(tp)
-				}
+				tp = pr.registerAnonymousTypes(tp)
 				onTypeResolved(tp)
 
 			})
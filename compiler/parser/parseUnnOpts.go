@@ -29,7 +29,7 @@ SCAN_LOOP:
 		peeker := lex.New()
 		// Peek for 1 token to find out whether
 		// the block ended or a new type began
-		tk, err := peeker.NextSkip(Skip{FragTkSpace})
+		tk, err := peeker.NextSkip(Skip{FragTkSpace, FragTkComment})
 		if pr.err(err) {
 			return nil
 		}
@@ -54,7 +54,7 @@ SCAN_LOOP:
 			// A type (optional ...)
 		case FragTkBlkEnd:
 			// End of the block
-			_, _ = lex.NextSkip(Skip{FragTkSpace})
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
 			break SCAN_LOOP
 		default:
 			// Unexpected token
@@ -102,6 +102,25 @@ SCAN_LOOP:
 					return
 				}
 
+				// Ensure the option types are unique since the type
+				// arguments of generic union types could be identical
+				for i, option := range typeOptions {
+					for _, other := range typeOptions[i+1:] {
+						if sameType(option, other) {
+							pr.err(&pErr{
+								at:   fBlockBegin.begin,
+								code: ErrUnionRedund,
+								message: fmt.Sprintf(
+									"redundant option-type %s in union type %s",
+									option,
+									unionType,
+								),
+							})
+							return
+						}
+					}
+				}
+
 				onTypesResolved(typeOptions)
 			}
 		})
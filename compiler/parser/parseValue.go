@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// parseValue parses a literal value
+func (pr *Parser) parseValue(lex *Lexer) *Value {
+	tk, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+	if pr.err(err) {
+		return nil
+	}
+	if tk == nil {
+		pr.err(&pErr{
+			at:      lex.Cursor(),
+			code:    ErrSyntax,
+			message: "unexpected end of file, expected value",
+		})
+		return nil
+	}
+
+	switch tk.id {
+	case FragTkNum:
+		return &Value{Src: tk, Kind: ValueKindNumber, Literal: tk.src}
+
+	case FragTkStr:
+		str, err := strconv.Unquote(tk.src)
+		if err != nil {
+			pr.err(&pErr{
+				at:      tk.begin,
+				code:    ErrSyntax,
+				message: fmt.Sprintf("illegal string literal %s (%s)", tk.src, err),
+			})
+			return nil
+		}
+		return &Value{Src: tk, Kind: ValueKindString, Literal: str}
+
+	case FragTkLatinAlphanum:
+		switch tk.src {
+		case KeywordTrue, KeywordFalse:
+			tk.id = FragTkKwdLit
+			return &Value{Src: tk, Kind: ValueKindBool, Literal: tk.src}
+		case KeywordNull:
+			tk.id = FragTkKwdLit
+			return &Value{Src: tk, Kind: ValueKindNull, Literal: tk.src}
+		}
+		tk.id = FragTkIdnEnumVal
+		if pr.err(verify(tk, "enum value", lowerCamelCase)) {
+			return nil
+		}
+		return &Value{Src: tk, Kind: ValueKindEnum, Literal: tk.src}
+
+	case FragTkSymList:
+		// Empty list
+		return &Value{
+			Src:   NewConstruct(lex, FragValList, tk),
+			Kind:  ValueKindList,
+			Items: []*Value{},
+		}
+
+	case FragTkSymMap:
+		return pr.parseValueList(lex, tk)
+
+	case FragTkBlk:
+		return pr.parseValueStruct(lex, tk)
+	}
+
+	pr.err(&pErr{
+		at:      tk.begin,
+		code:    ErrSyntax,
+		message: fmt.Sprintf("unexpected token '%s', expected value", tk.src),
+	})
+	return nil
+}
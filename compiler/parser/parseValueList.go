@@ -0,0 +1,47 @@
+package parser
+
+// parseValueList parses the items of a list literal
+// after the opening '[' was already read
+func (pr *Parser) parseValueList(lex *Lexer, fOpening *Token) *Value {
+	frags := []Fragment{fOpening}
+	items := []*Value{}
+
+	for {
+		tk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil
+		}
+		if tk != nil && tk.id == FragTkSymMapEnd {
+			// End of the list
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+			frags = append(frags, tk)
+			break
+		}
+
+		item := pr.parseValue(lex)
+		if item == nil {
+			return nil
+		}
+		frags = append(frags, item.Src)
+		items = append(items, item)
+
+		// Skip separator if any
+		sepTk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil
+		}
+		if sepTk != nil && sepTk.id == FragTkSymSep {
+			separator, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+			if pr.err(err) {
+				return nil
+			}
+			frags = append(frags, separator)
+		}
+	}
+
+	return &Value{
+		Src:   NewConstruct(lex, FragValList, frags...),
+		Kind:  ValueKindList,
+		Items: items,
+	}
+}
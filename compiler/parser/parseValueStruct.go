@@ -0,0 +1,89 @@
+package parser
+
+import "fmt"
+
+// parseValueStruct parses the fields of a struct literal
+// after the opening '{' was already read
+func (pr *Parser) parseValueStruct(lex *Lexer, fOpening *Token) *Value {
+	frags := []Fragment{fOpening}
+	fields := []*ValueField{}
+	byName := map[string]*Token{}
+
+	for {
+		tk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil
+		}
+		if tk != nil && tk.id == FragTkBlkEnd {
+			// End of the struct literal
+			_, _ = lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+			frags = append(frags, tk)
+			break
+		}
+
+		// Read field name
+		fName, err := readWord(
+			lex,
+			"struct field identifier",
+			FragTkIdnFld,
+			lowerCamelCase,
+		)
+		if pr.err(err) {
+			return nil
+		}
+
+		// Check for redeclarations
+		if defined, isDefined := byName[fName.src]; isDefined {
+			pr.err(&pErr{
+				at:   fName.begin,
+				code: ErrSyntax,
+				message: fmt.Sprintf(
+					"redeclaration of struct literal field %s "+
+						"(previously declared at %s)",
+					fName.src,
+					defined.begin,
+				),
+			})
+			return nil
+		}
+		byName[fName.src] = fName
+
+		// Read colon
+		fColon, err := readToken(lex, FragTkSymCol, "colon ':'")
+		if pr.err(err) {
+			return nil
+		}
+
+		val := pr.parseValue(lex)
+		if val == nil {
+			return nil
+		}
+
+		newField := &ValueField{
+			Src:   NewConstruct(lex, FragValField, fName, fColon, val.Src),
+			Name:  fName.src,
+			Value: val,
+		}
+		frags = append(frags, newField.Src)
+		fields = append(fields, newField)
+
+		// Skip separator if any
+		sepTk, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+		if pr.err(err) {
+			return nil
+		}
+		if sepTk != nil && sepTk.id == FragTkSymSep {
+			separator, err := lex.NextSkip(Skip{FragTkSpace, FragTkComment})
+			if pr.err(err) {
+				return nil
+			}
+			frags = append(frags, separator)
+		}
+	}
+
+	return &Value{
+		Src:    NewConstruct(lex, FragValStruct, frags...),
+		Kind:   ValueKindStruct,
+		Fields: fields,
+	}
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 )
 
@@ -11,6 +12,11 @@ type File struct {
 	Path string
 }
 
+// filePath returns the full path of the file
+func (fl *File) filePath() string {
+	return filepath.Join(fl.Path, fl.Name)
+}
+
 // SourceFile represents an input source file
 type SourceFile struct {
 	File
@@ -21,7 +27,12 @@ type SourceFile struct {
 type Parser struct {
 	errors            []Error
 	errorsLock        *sync.Mutex
+	warnings          []Error
+	maxErrors         int
+	declFailed        bool
+	failedTypeDecls   map[string]struct{}
 	deferredJobs      []func()
+	typeRefChecks     []func()
 	mod               *SchemaModel
 	lastIssuedGraphID GraphNodeID
 	lastIssuedTypeID  TypeID
@@ -31,17 +42,123 @@ type Parser struct {
 	typeByID          map[TypeID]Type
 	graphNodeByID     map[GraphNodeID]GraphNode
 	paramByID         map[ParamID]*Parameter
+	loader            SourceLoader
+	importedFiles     map[string]*File
+	importStack       []*File
+	env               *typeEnv
+	genericByName     map[string]*genericType
+	annotationSchemas map[string]AnnotationSchema
+	fileFrags         []Fragment
+	idLock            *IDLock
 }
 
 // NewParser creates a new GAPI parser instance
 func NewParser() (*Parser, error) {
 	return &Parser{
-		errorsLock: &sync.Mutex{},
+		errorsLock:        &sync.Mutex{},
+		loader:            FileLoader{},
+		annotationSchemas: make(map[string]AnnotationSchema),
 	}, nil
 }
 
+// SetSourceLoader sets the loader used for loading imported source files
+func (pr *Parser) SetSourceLoader(loader SourceLoader) {
+	pr.loader = loader
+}
+
+// SetMaxErrors sets the maximum number of errors after which the parser
+// stops parsing. A maximum of 0 removes the limit
+func (pr *Parser) SetMaxErrors(max int) {
+	pr.maxErrors = max
+}
+
+// SetIDLock sets the ID lock pinning the identifiers of types, graph nodes
+// and parameters. Identifiers of declarations that aren't pinned are issued
+// above the greatest pinned identifier. A nil lock restores
+// issuing identifiers in order of declaration
+func (pr *Parser) SetIDLock(lock *IDLock) {
+	pr.idLock = lock.Clone()
+}
+
+// IDLock returns the ID lock pinning all identifiers issued by the last
+// successful parsing including the ones pinned by the previously set lock,
+// or nil if parsing failed or wasn't yet executed
+func (pr *Parser) IDLock() *IDLock {
+	if len(pr.errors) > 0 || pr.mod == nil {
+		return nil
+	}
+	lock := pr.idLock.Clone()
+	if lock == nil {
+		lock = &IDLock{
+			Types:      make(map[string]TypeID, len(pr.typeByID)),
+			GraphNodes: make(map[string]GraphNodeID, len(pr.graphNodeByID)),
+			Params:     make(map[string]ParamID, len(pr.paramByID)),
+		}
+	}
+	for id, t := range pr.typeByID {
+		lock.Types[t.String()] = id
+	}
+	for id, node := range pr.graphNodeByID {
+		lock.GraphNodes[node.GraphNodeName()] = id
+	}
+	for id, param := range pr.paramByID {
+		lock.Params[paramDesignation(param)] = id
+	}
+	return lock
+}
+
+// RegisterAnnotation declares a known annotation. Once at least one
+// annotation is registered all unknown annotations and annotations
+// not matching their schema are reported as ErrAnnotationIllegal
+func (pr *Parser) RegisterAnnotation(schema AnnotationSchema) error {
+	if err := lowerCamelCase(schema.Name); err != nil {
+		return fmt.Errorf(
+			"illegal annotation name '%s' (%s)",
+			schema.Name,
+			err,
+		)
+	}
+	if _, isDefined := pr.annotationSchemas[schema.Name]; isDefined {
+		return fmt.Errorf("annotation %s already registered", schema.Name)
+	}
+	optional := false
+	for i, param := range schema.Params {
+		if param.Name == "" {
+			return fmt.Errorf(
+				"parameter %d of annotation %s is unnamed",
+				i,
+				schema.Name,
+			)
+		}
+		if param.Type == nil {
+			return fmt.Errorf(
+				"parameter %s of annotation %s is missing a type",
+				param.Name,
+				schema.Name,
+			)
+		}
+		if optional && !param.Optional {
+			return fmt.Errorf(
+				"required parameter %s of annotation %s "+
+					"follows an optional one",
+				param.Name,
+				schema.Name,
+			)
+		}
+		optional = param.Optional
+	}
+	pr.annotationSchemas[schema.Name] = schema
+	return nil
+}
+
 // ResetState resets the parser state
 func (pr *Parser) ResetState() {
+	pr.errors = nil
+	pr.warnings = nil
+	pr.deferredJobs = nil
+	pr.typeRefChecks = nil
+	pr.declFailed = false
+	pr.failedTypeDecls = make(map[string]struct{})
 	pr.mod = nil
 	pr.lastIssuedGraphID = 0
 	pr.lastIssuedTypeID = TypeIDUserTypeOffset
@@ -51,6 +168,11 @@ func (pr *Parser) ResetState() {
 	pr.typeByID = make(map[TypeID]Type)
 	pr.graphNodeByID = make(map[GraphNodeID]GraphNode)
 	pr.paramByID = make(map[ParamID]*Parameter)
+	pr.importedFiles = make(map[string]*File)
+	pr.importStack = nil
+	pr.env = nil
+	pr.genericByName = make(map[string]*genericType)
+	pr.fileFrags = nil
 }
 
 // deferJob defers a function up until the parser has finished scanning
@@ -58,6 +180,30 @@ func (pr *Parser) deferJob(job func()) {
 	pr.deferredJobs = append(pr.deferredJobs, job)
 }
 
+// deferTypeRefCheck registers a check reporting the given terminal type
+// reference if it's undefined. The check is only executed
+// if the declaration containing the reference fails since the deferred
+// jobs of failed declarations are dropped
+func (pr *Parser) deferTypeRefCheck(tk *Token) {
+	env := pr.env
+	pr.typeRefChecks = append(pr.typeRefChecks, func() {
+		if _, isParam := env.arg(tk.src); isParam ||
+			pr.findTypeByDesignation(tk.src) != nil ||
+			pr.genericByName[tk.src] != nil ||
+			pr.isFailedTypeDecl(tk.src) {
+			return
+		}
+		pr.err(&pErr{
+			at:   tk.begin,
+			code: ErrTypeUndef,
+			message: fmt.Sprintf(
+				"terminal type %s is undefined",
+				tk.src,
+			),
+		})
+	})
+}
+
 // err logs a parser error returning true if an error was logged,
 // otherwise returning false
 func (pr *Parser) err(err Error) bool {
@@ -67,12 +213,35 @@ func (pr *Parser) err(err Error) bool {
 	if err.Code() == 0 {
 		panic("invalid error code (0)")
 	}
+	if pr.env.isInstance() {
+		// Errors found while parsing the declaration of an instance
+		// were already reported when parsing the template
+		return true
+	}
 	pr.errorsLock.Lock()
-	pr.errors = append(pr.errors, err)
+	if pr.maxErrors < 1 || len(pr.errors) < pr.maxErrors {
+		pr.errors = append(pr.errors, err)
+	}
 	pr.errorsLock.Unlock()
 	return true
 }
 
+// warn logs a compiler warning, warnings don't cause parsing to fail
+func (pr *Parser) warn(warning *pErr) {
+	warning.severity = SeverityWarning
+	pr.errorsLock.Lock()
+	pr.warnings = append(pr.warnings, warning)
+	pr.errorsLock.Unlock()
+}
+
+// errLimitReached returns true if the maximum number of errors was reached,
+// otherwise returns false
+func (pr *Parser) errLimitReached() bool {
+	pr.errorsLock.Lock()
+	defer pr.errorsLock.Unlock()
+	return pr.maxErrors > 0 && len(pr.errors) >= pr.maxErrors
+}
+
 // Errors returns a copy of the list of all compiler errors
 func (pr *Parser) Errors() []Error {
 	errs := make([]Error, len(pr.errors))
@@ -80,6 +249,13 @@ func (pr *Parser) Errors() []Error {
 	return errs
 }
 
+// Warnings returns a copy of the list of all compiler warnings
+func (pr *Parser) Warnings() []Error {
+	warnings := make([]Error, len(pr.warnings))
+	copy(warnings, pr.warnings)
+	return warnings
+}
+
 // SchemaModel returns a copy of the schema model or nil if parsing failed
 // or wasn't yet executed
 func (pr *Parser) SchemaModel() *SchemaModel {
@@ -89,6 +265,17 @@ func (pr *Parser) SchemaModel() *SchemaModel {
 	return pr.mod.Clone()
 }
 
+// Fragments returns the fragment trees of all parsed schema files
+// including imported ones or nil if parsing failed or wasn't yet executed
+func (pr *Parser) Fragments() []Fragment {
+	if len(pr.errors) > 0 || len(pr.fileFrags) < 1 {
+		return nil
+	}
+	frags := make([]Fragment, len(pr.fileFrags))
+	copy(frags, pr.fileFrags)
+	return frags
+}
+
 // Parse starts parsing the source code reseting the parser
 func (pr *Parser) Parse(source SourceFile) error {
 	pr.ResetState()
@@ -101,10 +288,19 @@ func (pr *Parser) Parse(source SourceFile) error {
 		UnionTypes:     make([]Type, 0),
 		QueryEndpoints: make([]*Query, 0),
 		Mutations:      make([]*Mutation, 0),
+		Subscriptions:  make([]*Subscription, 0),
+	}
+
+	// Make sure identifiers don't collide with pinned ones
+	pr.applyIDLock()
+	if len(pr.errors) > 0 {
+		return ParseErr{pr.Errors()}
 	}
 
 	// Initialize the lexer
 	lexer := NewLexer(source)
+	pr.importedFiles[source.filePath()] = &lexer.src.File
+	pr.importStack = []*File{&lexer.src.File}
 
 	// Parse file
 	fileFrag := pr.parseScmFile(lexer)
@@ -112,21 +308,38 @@ func (pr *Parser) Parse(source SourceFile) error {
 		goto END
 	}
 
+	// Determine the purity of trait types once all types are resolved
+	pr.deferJob(pr.determineTraitPurity)
+
+	// Check the endpoints for references to deprecated types
+	// once all types are resolved
+	pr.deferJob(pr.checkDeprecatedTypeRefs)
+
 	// Execute all deferred jobs
 	for j := 0; j < len(pr.deferredJobs); j++ {
+		if pr.errLimitReached() {
+			goto END
+		}
 		pr.deferredJobs[j]()
 	}
 
 	// Sort everything by name (ascending)
-	wg.Add(7)
+	wg.Add(9)
 	go func() { sortTypesByName(pr.mod.Types); wg.Done() }()
 	go func() { sortTypesByName(pr.mod.EnumTypes); wg.Done() }()
 	go func() { sortTypesByName(pr.mod.UnionTypes); wg.Done() }()
 	go func() { sortTypesByName(pr.mod.StructTypes); wg.Done() }()
 	go func() { sortTypesByName(pr.mod.ResolverTypes); wg.Done() }()
+	go func() {
+		sortTypesByName(pr.mod.TraitTypes)
+		for _, t := range pr.mod.TraitTypes {
+			sortTypesByName(t.(*TypeTrait).Implementations)
+		}
+		wg.Done()
+	}()
 	go func() { sortQueryEndpointsByName(pr.mod.QueryEndpoints); wg.Done() }()
 	go func() { sortMutationsByName(pr.mod.Mutations); wg.Done() }()
-	//TODO: sort trait types
+	go func() { sortSubscriptionsByName(pr.mod.Subscriptions); wg.Done() }()
 	wg.Wait()
 
 	// Perform semantic analysis
@@ -159,7 +372,11 @@ func (pr *Parser) Parse(source SourceFile) error {
 			})
 		}
 	}()
-	if len(pr.mod.QueryEndpoints) < 1 && len(pr.mod.Mutations) < 1 {
+	// Endpoints might be missing because of failed declarations
+	if !pr.declFailed &&
+		len(pr.mod.QueryEndpoints) < 1 &&
+		len(pr.mod.Mutations) < 1 &&
+		len(pr.mod.Subscriptions) < 1 {
 		pr.err(&pErr{
 			code:    ErrNoEndpoints,
 			message: fmt.Sprintf("The schema is missing API endpoints"),
@@ -167,6 +384,14 @@ func (pr *Parser) Parse(source SourceFile) error {
 	}
 	wg.Wait()
 
+	// Look for suspicious declarations once the model is complete
+	if len(pr.errors) < 1 {
+		pr.checkUnusedTypes()
+		pr.checkAliasChains()
+		pr.checkSingleValEnums()
+		pr.checkNoneUnions()
+	}
+
 END:
 	if len(pr.errors) > 0 {
 		return ParseErr{pr.Errors()}
@@ -1,7 +1,10 @@
 package parser_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"path"
 	"strings"
 	"testing"
 
@@ -50,6 +53,9 @@ type ErrCase struct {
 
 	// Errs defines all expected compiler error codes
 	Errs []ErrCode
+
+	// Setup optionally configures the parser before parsing
+	Setup func(*parser.Parser)
 }
 
 func testErrs(t *testing.T, cases map[string]ErrCase) {
@@ -62,6 +68,9 @@ func testErrs(t *testing.T, cases map[string]ErrCase) {
 			pr, err := parser.NewParser()
 			require.NoError(t, err)
 			require.NotNil(t, pr)
+			if errCase.Setup != nil {
+				errCase.Setup(pr)
+			}
 
 			// Parse
 			require.Error(t, pr.Parse(src(errCase.Src)))
@@ -208,6 +217,15 @@ func TestDeclTypeErrs(t *testing.T) {
 		"Float64",
 		"String",
 		"Time",
+		"Int8",
+		"Int16",
+		"Uint8",
+		"Uint16",
+		"Float32",
+		"Duration",
+		"Date",
+		"UUID",
+		"Decimal",
 	}
 	for _, primTypeName := range primitiveTypeNames {
 		testCases[fmt.Sprintf("RedeclPrimitive(%s)", primTypeName)] = ErrCase{
@@ -326,6 +344,14 @@ func TestDeclAliasTypeErrs(t *testing.T) {
 				parser.ErrAliasRecurs,
 			},
 		},
+		"AliasCycleFieldType": ErrCase{
+			Src: `schema test
+			struct S { a A }
+			alias A = B
+			alias B = A
+			query q(p A) S`,
+			Errs: []ErrCode{parser.ErrAliasRecurs},
+		},
 	})
 }
 
@@ -668,7 +694,7 @@ func TestModStructs(t *testing.T) {
 	test(t, src, func(mod SchemaModel) {
 		require.Len(t, mod.QueryEndpoints, 1)
 		require.Len(t, mod.Mutations, 0)
-		require.Len(t, mod.Types, 3+10)
+		require.Len(t, mod.Types, 3+16)
 
 		s1 := mod.StructTypes[0]
 		s2 := mod.StructTypes[1]
@@ -1022,7 +1048,7 @@ func TestModResolvers(t *testing.T) {
 	test(t, src, func(mod SchemaModel) {
 		require.Len(t, mod.QueryEndpoints, 1)
 		require.Len(t, mod.Mutations, 0)
-		require.Len(t, mod.Types, 4+11)
+		require.Len(t, mod.Types, 4+18)
 
 		r1 := mod.ResolverTypes[0]
 		r2 := mod.ResolverTypes[1]
@@ -1418,6 +1444,391 @@ func TestDeclResolverTypeErrs(t *testing.T) {
 	})
 }
 
+// TestModTraits tests trait type declarations in SchemaModel
+func TestModTraits(t *testing.T) {
+	src := `schema test
+	resolver R {
+		t T1
+		l []T2
+	}
+	trait T1 {
+		x String
+		y ?[]Int32
+	}
+	trait T2 {
+		x(a Int32) String
+	}
+	trait T3 {
+		r R
+	}
+	trait T4 {
+		t ?T1
+	}
+	trait T5 {
+		t T3
+	}
+	query q T1
+	query q2(t T4) ?T2`
+
+	test(t, src, func(mod SchemaModel) {
+		require.Len(t, mod.QueryEndpoints, 2)
+		require.Len(t, mod.TraitTypes, 5)
+		require.Len(t, mod.ResolverTypes, 1)
+
+		type Expectation struct {
+			Name  string
+			Pure  bool
+			Props []string
+		}
+		expected := []Expectation{
+			Expectation{"T1", true, []string{"x", "y"}},
+			Expectation{"T2", false, []string{"x"}},
+			Expectation{"T3", false, []string{"r"}},
+			Expectation{"T4", true, []string{"t"}},
+			Expectation{"T5", false, []string{"t"}},
+		}
+		for i, expec := range expected {
+			tp := mod.TraitTypes[i]
+			require.Equal(t, expec.Name, tp.String())
+			require.IsType(t, &parser.TypeTrait{}, tp)
+			trait := tp.(*parser.TypeTrait)
+			require.Equal(t, expec.Pure, trait.Pure)
+			require.Equal(t, expec.Pure, trait.IsPure())
+			require.Equal(t, tp, mod.FindTypeByDesignation(expec.Name))
+
+			require.Len(t, trait.Properties, len(expec.Props))
+			for j, propName := range expec.Props {
+				prop := trait.Properties[j]
+				require.Equal(t, propName, prop.Name)
+				require.Equal(t, trait, prop.Trait)
+				require.Equal(t, prop, mod.FindGraphNodeByID(prop.GraphID))
+				for _, param := range prop.Parameters {
+					require.Equal(t, param, mod.FindParameterByID(param.ID))
+				}
+			}
+		}
+
+		t1 := mod.TraitTypes[0]
+		rsv := mod.ResolverTypes[0].(*parser.TypeResolver)
+		require.Equal(t, t1, rsv.Properties[0].Type)
+		require.Equal(t, t1, mod.QueryEndpoints[0].Type)
+	})
+}
+
+// TestDeclTraitTypeErrs tests trait type declaration errors
+func TestDeclTraitTypeErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"IllegalTypeName": ErrCase{
+			Src: `schema test
+			trait illegalName {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"NoProps": ErrCase{
+			Src: `schema test
+			trait T {}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitNoProps},
+		},
+		"RedundantProp": ErrCase{
+			Src: `schema test
+			trait T {
+				foo String
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitPropRedecl},
+		},
+		"NoneProp": ErrCase{
+			Src: `schema test
+			trait T {
+				foo None
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UndefinedPropType": ErrCase{
+			Src: `schema test
+			trait T {
+				foo Undefined
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"RedeclType": ErrCase{
+			Src: `schema test
+			resolver T {
+				foo String
+			}
+			trait T {
+				bar String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTypeRedecl},
+		},
+		"ImpureParam": ErrCase{
+			Src: `schema test
+			query q(t T) Bool
+			trait T {
+				foo(x Int32) String
+			}`,
+			Errs: []ErrCode{parser.ErrParamImpure},
+		},
+		"ImpureStructField": ErrCase{
+			Src: `schema test
+			struct S {
+				t T
+			}
+			trait T {
+				r R
+			}
+			resolver R {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrStructFieldImpure},
+		},
+	})
+}
+
+// TestModTraitImpls tests trait implementations in SchemaModel
+func TestModTraitImpls(t *testing.T) {
+	src := `schema test
+	trait Named {
+		name String
+	}
+	trait Sized {
+		size(unit ?String) Uint64
+	}
+	resolver File implements Named, Sized {
+		name String
+		size(unit ?String) Uint64
+		body []Byte
+	}
+	resolver Dir implements Named {
+		name String
+		files []File
+	}
+	struct Tag implements Named {
+		name String
+	}
+	query q Named`
+
+	test(t, src, func(mod SchemaModel) {
+		require.Len(t, mod.TraitTypes, 2)
+		named := mod.TraitTypes[0].(*parser.TypeTrait)
+		sized := mod.TraitTypes[1].(*parser.TypeTrait)
+		require.Equal(t, "Named", named.Name)
+		require.Equal(t, "Sized", sized.Name)
+
+		dir := mod.FindTypeByDesignation("Dir").(*parser.TypeResolver)
+		file := mod.FindTypeByDesignation("File").(*parser.TypeResolver)
+		tag := mod.FindTypeByDesignation("Tag").(*parser.TypeStruct)
+
+		require.Equal(t, []*parser.TypeTrait{named, sized}, file.Implements)
+		require.Equal(t, []*parser.TypeTrait{named}, dir.Implements)
+		require.Equal(t, []*parser.TypeTrait{named}, tag.Implements)
+
+		require.Equal(t, []parser.Type{dir, file, tag}, named.Implementations)
+		require.Equal(t, []parser.Type{file}, sized.Implementations)
+	})
+}
+
+// TestTraitImplErrs tests trait implementation errors
+func TestTraitImplErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"UndefinedTrait": ErrCase{
+			Src: `schema test
+			resolver R implements T {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"NonTrait": ErrCase{
+			Src: `schema test
+			resolver R implements S {
+				foo String
+			}
+			struct S {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplNonTrait},
+		},
+		"Redundant": ErrCase{
+			Src: `schema test
+			trait T {
+				foo String
+			}
+			resolver R implements T, T {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplRedund},
+		},
+		"MissingProp": ErrCase{
+			Src: `schema test
+			trait T {
+				foo String
+				bar String
+			}
+			resolver R implements T {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplMissingProp},
+		},
+		"TypeMismatch": ErrCase{
+			Src: `schema test
+			trait T {
+				foo String
+			}
+			resolver R implements T {
+				foo ?String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplPropMismatch},
+		},
+		"ParamMismatch": ErrCase{
+			Src: `schema test
+			trait T {
+				foo(x Int32) String
+			}
+			resolver R implements T {
+				foo(y Int32) String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplPropMismatch},
+		},
+		"ParamTypeMismatch": ErrCase{
+			Src: `schema test
+			trait T {
+				foo(x Int32) String
+			}
+			resolver R implements T {
+				foo(x Int64) String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplPropMismatch},
+		},
+		"StructImplImpureTrait": ErrCase{
+			Src: `schema test
+			trait T {
+				foo(x Int32) String
+			}
+			struct S implements T {
+				foo String
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrTraitImplPropMismatch},
+		},
+	})
+}
+
+// TestModDocs tests documentation of declarations in SchemaModel
+func TestModDocs(t *testing.T) {
+	src := "schema test\n" +
+		"# Alias documentation\n" +
+		"alias A = String\n" +
+		"# Enum documentation\n" +
+		"#\n" +
+		"#   indented\n" +
+		"enum E {\n" +
+		"	# Value documentation\n" +
+		"	val\n" +
+		"	undocumented\n" +
+		"}\n" +
+		"struct S {\n" +
+		"	# Field documentation\n" +
+		"	fld String\n" +
+		"}\n" +
+		"#Resolver documentation\t \r\n" +
+		"resolver R {\n" +
+		"	# Property documentation\n" +
+		"	prop(\n" +
+		"		# Parameter documentation\n" +
+		"		param String,\n" +
+		"	) String\n" +
+		"}\n" +
+		"# Query documentation\n" +
+		"query q(# Query parameter documentation\n x Int32) Bool\n" +
+		"# Mutation documentation\n" +
+		"mutation m Bool\n" +
+		"# Subscription documentation\n" +
+		"subscription s Bool\n"
+
+	test(t, src, func(mod SchemaModel) {
+		require.Equal(
+			t,
+			"Alias documentation",
+			mod.AliasTypes[0].(*parser.TypeAlias).Doc,
+		)
+
+		enm := mod.EnumTypes[0].(*parser.TypeEnum)
+		require.Equal(t, "Enum documentation\n\n  indented", enm.Doc)
+		require.Equal(t, "Value documentation", enm.Values[0].Doc)
+		require.Equal(t, "", enm.Values[1].Doc)
+
+		str := mod.StructTypes[0].(*parser.TypeStruct)
+		require.Equal(t, "", str.Doc)
+		require.Equal(t, "Field documentation", str.Fields[0].Doc)
+
+		rsv := mod.ResolverTypes[0].(*parser.TypeResolver)
+		require.Equal(t, "Resolver documentation", rsv.Doc)
+		require.Equal(t, "Property documentation", rsv.Properties[0].Doc)
+		require.Equal(
+			t,
+			"Parameter documentation",
+			rsv.Properties[0].Parameters[0].Doc,
+		)
+
+		require.Equal(t, "Query documentation", mod.QueryEndpoints[0].Doc)
+		require.Equal(
+			t,
+			"Query parameter documentation",
+			mod.QueryEndpoints[0].Parameters[0].Doc,
+		)
+		require.Equal(t, "Mutation documentation", mod.Mutations[0].Doc)
+		require.Equal(
+			t,
+			"Subscription documentation",
+			mod.Subscriptions[0].Doc,
+		)
+	})
+}
+
+// TestDocErrs tests documentation errors
+func TestDocErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"DanglingEOF": ErrCase{
+			Src: `schema test
+			query q Bool
+			# dangling`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"DanglingBlockEnd": ErrCase{
+			Src: `schema test
+			struct S {
+				x String
+				# dangling
+			}
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"DanglingParamsEnd": ErrCase{
+			Src: `schema test
+			query q(
+				x String,
+				# dangling
+			) Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
 // TestModQueries tests query declarations in SchemaModel
 func TestModQueries(t *testing.T) {
 	src := `schema test
@@ -1696,71 +2107,211 @@ func TestModMutations(t *testing.T) {
 	})
 }
 
-// TestDeclQueryErrs tests query endpoint declaration errors
-func TestDeclQueryErrs(t *testing.T) {
-	testErrs(t, map[string]ErrCase{
-		"IllegalTypeName": ErrCase{
-			Src: `schema test
-			query IllegalName String
-			query q Bool`,
-			Errs: []ErrCode{parser.ErrSyntax},
-		},
-		"IllegalTypeName2": ErrCase{
-			Src: `schema test
-			query _illegalName String
-			query q Bool`,
-			Errs: []ErrCode{parser.ErrSyntax},
-		},
-		"IllegalTypeName3": ErrCase{
-			Src: `schema test
-			query illegal_Name String
-			query q Bool`,
-			Errs: []ErrCode{parser.ErrSyntax},
-		},
-	})
-}
+// TestModSubscriptions tests subscription declarations in SchemaModel
+func TestModSubscriptions(t *testing.T) {
+	src := `schema test
+	struct Foo {
+		foo String
+	}
+	resolver Bar {
+		bar String
+	}
+	subscription foo Foo
+	subscription bar Bar
+	subscription str String
+	subscription foo2(foo Foo) Foo
+	subscription bar2(bar Int32, baz Float64) Bar
+	subscription baz(
+		first Int32,
+		second Bool,
+		third Uint64,
+	) String
+	`
 
-// TestParamImpureType tests specifying parameters of non-pure types
-func TestParamImpureType(t *testing.T) {
-	testErrs(t, map[string]ErrCase{
-		"Resolver": ErrCase{
-			Src: `schema test
-			resolver R {
-				x Int32
-			}
-			query q(param R) String`,
-			Errs: []ErrCode{parser.ErrParamImpure},
-		},
-		"ImpureUnion": ErrCase{
-			Src: `schema test
-			resolver R {
-				x Int32
-			}
-			union U {
-				R
-				Int32
-			}
-			query q(param U) String`,
-			Errs: []ErrCode{parser.ErrParamImpure},
-		},
-		"ImpureAliasToResolver": ErrCase{
-			Src: `schema test
-			resolver R {
-				x Int32
-			}
-			alias A = R
-			query q(param A) String`,
-			Errs: []ErrCode{parser.ErrParamImpure},
-		},
-		"ImpureUnionOfImpureAlias": ErrCase{
-			Src: `schema test
-			resolver R {
-				x Int32
-			}
-			alias A = R
-			union U {
-				Int32
-				A
+	test(t, src, func(mod SchemaModel) {
+		require.Len(t, mod.Types, 2)
+		require.Len(t, mod.Subscriptions, 6)
+		require.Len(t, mod.Mutations, 0)
+		require.Len(t, mod.QueryEndpoints, 0)
+
+		require.Len(t, mod.StructTypes, 1)
+		tFoo := mod.StructTypes[0]
+
+		require.Len(t, mod.ResolverTypes, 1)
+		tBar := mod.ResolverTypes[0]
+
+		expected := []parser.Subscription{
+			parser.Subscription{
+				GraphID: 4,
+				Name:    "bar",
+				Type:    tBar,
+			},
+			parser.Subscription{
+				GraphID: 7,
+				Name:    "bar2",
+				Type:    tBar,
+				Parameters: []*parser.Parameter{
+					&parser.Parameter{
+						ID:   2,
+						Name: "bar",
+						Type: parser.TypeStdInt32{},
+					},
+					&parser.Parameter{
+						ID:   3,
+						Name: "baz",
+						Type: parser.TypeStdFloat64{},
+					},
+				},
+			},
+			parser.Subscription{
+				GraphID: 8,
+				Name:    "baz",
+				Type:    parser.TypeStdString{},
+				Parameters: []*parser.Parameter{
+					&parser.Parameter{
+						ID:   4,
+						Name: "first",
+						Type: parser.TypeStdInt32{},
+					},
+					&parser.Parameter{
+						ID:   5,
+						Name: "second",
+						Type: parser.TypeStdBool{},
+					},
+					&parser.Parameter{
+						ID:   6,
+						Name: "third",
+						Type: parser.TypeStdUint64{},
+					},
+				},
+			},
+			parser.Subscription{
+				GraphID: 3,
+				Name:    "foo",
+				Type:    tFoo,
+			},
+			parser.Subscription{
+				GraphID: 6,
+				Name:    "foo2",
+				Type:    tFoo,
+				Parameters: []*parser.Parameter{
+					&parser.Parameter{
+						ID:   1,
+						Name: "foo",
+						Type: tFoo,
+					},
+				},
+			},
+			parser.Subscription{
+				GraphID: 5,
+				Name:    "str",
+				Type:    parser.TypeStdString{},
+			},
+		}
+		require.Len(t, mod.Subscriptions, len(expected))
+		for i1, expec := range expected {
+			require.IsType(t, parser.Subscription{}, expec)
+
+			actual := mod.Subscriptions[i1]
+			require.Equal(t, expec.Name, actual.Name)
+			require.Equal(t, expec.GraphID, actual.GraphID)
+			require.Equal(t, expec.Type, actual.Type)
+
+			// Make sure the graph nodes are registered correctly
+			foundNode := mod.FindGraphNodeByID(expec.GraphID)
+			require.Equal(t, actual, foundNode)
+
+			// Make sure parameters match expectations
+			require.Len(t, actual.Parameters, len(expec.Parameters))
+			for i2, param := range expec.Parameters {
+				actualParam := actual.Parameters[i2]
+				require.Equal(t, param.Name, actualParam.Name)
+				require.Equal(t, param.ID, actualParam.ID)
+				require.Equal(t, param.Type, actualParam.Type)
+				require.IsType(
+					t,
+					&parser.Subscription{},
+					actualParam.Target,
+				)
+				require.Equal(
+					t,
+					actual,
+					actualParam.Target.(*parser.Subscription),
+				)
+
+				// Make sure parameters are registered correctly
+				regParam := mod.FindParameterByID(param.ID)
+				require.Equal(t, actualParam, regParam)
+			}
+		}
+	})
+}
+
+// TestDeclQueryErrs tests query endpoint declaration errors
+func TestDeclQueryErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"IllegalTypeName": ErrCase{
+			Src: `schema test
+			query IllegalName String
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"IllegalTypeName2": ErrCase{
+			Src: `schema test
+			query _illegalName String
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"IllegalTypeName3": ErrCase{
+			Src: `schema test
+			query illegal_Name String
+			query q Bool`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestParamImpureType tests specifying parameters of non-pure types
+func TestParamImpureType(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"Resolver": ErrCase{
+			Src: `schema test
+			resolver R {
+				x Int32
+			}
+			query q(param R) String`,
+			Errs: []ErrCode{parser.ErrParamImpure},
+		},
+		"ImpureUnion": ErrCase{
+			Src: `schema test
+			resolver R {
+				x Int32
+			}
+			union U {
+				R
+				Int32
+			}
+			query q(param U) String`,
+			Errs: []ErrCode{parser.ErrParamImpure},
+		},
+		"ImpureAliasToResolver": ErrCase{
+			Src: `schema test
+			resolver R {
+				x Int32
+			}
+			alias A = R
+			query q(param A) String`,
+			Errs: []ErrCode{parser.ErrParamImpure},
+		},
+		"ImpureUnionOfImpureAlias": ErrCase{
+			Src: `schema test
+			resolver R {
+				x Int32
+			}
+			alias A = R
+			union U {
+				Int32
+				A
 			}
 			query q(param U) String`,
 			Errs: []ErrCode{parser.ErrParamImpure},
@@ -1953,6 +2504,16 @@ func TestTypeErr(t *testing.T) {
 			mutation m(x Undefined) String`,
 			Errs: []ErrCode{parser.ErrTypeUndef},
 		},
+		"SubscriptionUndefinedType": ErrCase{
+			Src: `schema test
+			subscription s Undefined`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"SubscriptionParamUndefinedType": ErrCase{
+			Src: `schema test
+			subscription s(x Undefined) String`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
 	})
 }
 
@@ -2015,5 +2576,2230 @@ func TestGraphRootNodeRedecl(t *testing.T) {
 			mutation q Int32`,
 			Errs: []ErrCode{parser.ErrGraphRootNodeRedecl},
 		},
+		"Subscriptions": ErrCase{
+			Src: `schema test
+			subscription s String
+			subscription s Int32`,
+			Errs: []ErrCode{parser.ErrGraphRootNodeRedecl},
+		},
+		"QuerySubscription": ErrCase{
+			Src: `schema test
+			query q String
+			subscription q Int32`,
+			Errs: []ErrCode{parser.ErrGraphRootNodeRedecl},
+		},
+	})
+}
+
+// testLoader represents an in-memory source loader
+// mapping absolute file paths to their source code
+type testLoader map[string]string
+
+func (ld testLoader) Load(
+	filePath string,
+	importer *parser.File,
+) (parser.SourceFile, error) {
+	if !path.IsAbs(filePath) {
+		filePath = path.Join(importer.Path, filePath)
+	}
+	contents, found := ld[filePath]
+	if !found {
+		return parser.SourceFile{}, errors.New("file not found")
+	}
+	return parser.SourceFile{
+		File: parser.File{
+			Name: path.Base(filePath),
+			Path: path.Dir(filePath),
+		},
+		Src: contents,
+	}, nil
+}
+
+func parseImports(
+	t *testing.T,
+	source string,
+	files testLoader,
+) *parser.Parser {
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+	require.NotNil(t, pr)
+	pr.SetSourceLoader(files)
+	pr.Parse(src(source))
+	return pr
+}
+
+func TestModImports(t *testing.T) {
+	pr := parseImports(t, `schema test
+	import "types/a.gapi"
+	import "types/b.gapi"
+	query q A
+	`, testLoader{
+		"/tests/types/a.gapi": `schema test
+		import "b.gapi"
+		struct A { b B }
+		`,
+		"/tests/types/b.gapi": `schema test
+		enum B { foo bar }
+		`,
+	})
+	require.Len(t, pr.Errors(), 0)
+	mod := pr.SchemaModel()
+	require.NotNil(t, mod)
+	verifyModel(t, mod)
+
+	require.Equal(t, "test", mod.SchemaName)
+	require.Len(t, mod.Types, 2)
+	require.Len(t, mod.StructTypes, 1)
+	require.Len(t, mod.EnumTypes, 1)
+
+	tA := mod.FindTypeByDesignation("A").(*parser.TypeStruct)
+	tB := mod.FindTypeByDesignation("B").(*parser.TypeEnum)
+	require.Equal(t, tB, tA.Fields[0].Type)
+	require.Equal(t, "a.gapi", tA.Src.Begin().File.Name)
+	require.Equal(t, "/tests/types", tA.Src.Begin().File.Path)
+	require.Equal(t, "b.gapi", tB.Src.Begin().File.Name)
+	require.Equal(t, tA, mod.QueryEndpoints[0].Type)
+}
+
+func TestImportErrFile(t *testing.T) {
+	pr := parseImports(t, `schema test
+	import "a.gapi"
+	query q A
+	`, testLoader{
+		"/tests/a.gapi": `schema test
+		struct A { b Undefined }
+		`,
+	})
+	errs := pr.Errors()
+	require.Len(t, errs, 1)
+	require.Equal(t, parser.ErrTypeUndef, errs[0].Code())
+	require.Equal(t, "a.gapi", errs[0].At().File.Name)
+	require.Equal(t, "/tests", errs[0].At().File.Path)
+	require.Nil(t, pr.SchemaModel())
+}
+
+func TestImportRedeclErrs(t *testing.T) {
+	pr := parseImports(t, `schema test
+	import "a.gapi"
+	enum A { foo bar }
+	query q A
+	`, testLoader{
+		"/tests/a.gapi": `schema test
+		struct A { foo String }
+		`,
 	})
+	errs := pr.Errors()
+	require.Len(t, errs, 1)
+	require.Equal(t, parser.ErrTypeRedecl, errs[0].Code())
+	require.Equal(t, "test.schema", errs[0].At().File.Name)
+	require.Contains(t, errs[0].Message(), "a.gapi:2:3")
+}
+
+func TestImportErrs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Src   string
+		Files testLoader
+		Errs  []ErrCode
+	}{
+		"NotFound": {
+			Src: `schema test
+			import "inexistent.gapi"
+			query q String`,
+			Errs: []ErrCode{parser.ErrImportLoad},
+		},
+		"MissingPath": {
+			Src: `schema test
+			import
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"EmptyPath": {
+			Src: `schema test
+			import ""
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UnterminatedPath": {
+			Src: `schema test
+			import "a.gapi
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"SchemaMismatch": {
+			Src: `schema test
+			import "a.gapi"
+			query q String`,
+			Files: testLoader{
+				"/tests/a.gapi": `schema other
+				enum E { foo bar }`,
+			},
+			Errs: []ErrCode{parser.ErrImportSchemaMismatch},
+		},
+		"SelfImport": {
+			Src: `schema test
+			import "test.schema"
+			query q String`,
+			Files: testLoader{
+				"/tests/test.schema": `schema test`,
+			},
+			Errs: []ErrCode{parser.ErrImportCycle},
+		},
+		"Cycle": {
+			Src: `schema test
+			import "a.gapi"
+			query q String`,
+			Files: testLoader{
+				"/tests/a.gapi": `schema test
+				import "b.gapi"`,
+				"/tests/b.gapi": `schema test
+				import "a.gapi"`,
+			},
+			Errs: []ErrCode{parser.ErrImportCycle},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pr := parseImports(t, tc.Src, tc.Files)
+			actualErrs := pr.Errors()
+			actualCodes := make([]ErrCode, len(actualErrs))
+			for i, err := range actualErrs {
+				actualCodes[i] = err.Code()
+			}
+			require.Equal(t, tc.Errs, actualCodes)
+			require.Nil(t, pr.SchemaModel())
+		})
+	}
+}
+
+func TestErrRecovery(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"MultipleDecls": ErrCase{
+			Src: `schema test
+			struct S { a Strin g }
+			enum E { foo Bar }
+			query q(a Int32 b: String) S
+			resolver R { x Undefined }
+			query q2 String`,
+			Errs: []ErrCode{
+				parser.ErrSyntax,
+				parser.ErrSyntax,
+				parser.ErrSyntax,
+				parser.ErrTypeUndef,
+				parser.ErrTypeUndef,
+			},
+		},
+		"UndefinedTypeInFailedDecl": ErrCase{
+			Src: `schema test
+			resolver R { a Foo  b Int32 Int32 }
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax, parser.ErrTypeUndef},
+		},
+		"StrayBlockEnds": ErrCase{
+			Src: `schema test
+			}
+			}
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax, parser.ErrSyntax},
+		},
+		"UnexpectedToken": ErrCase{
+			Src: `schema test
+			foo
+			query q String
+			) {
+			query q2 String`,
+			Errs: []ErrCode{parser.ErrSyntax, parser.ErrSyntax},
+		},
+		"IllegalSymbols": ErrCase{
+			Src: `schema test
+			$
+			struct S { a $ }
+			query q S`,
+			Errs: []ErrCode{parser.ErrSyntax, parser.ErrSyntax},
+		},
+		"KeywordAfterFailedDecl": ErrCase{
+			Src: `schema test
+			query q
+			struct S { query String }
+			query q2 X`,
+			Errs: []ErrCode{parser.ErrSyntax, parser.ErrTypeUndef},
+		},
+		"FailedTypeDeclReference": ErrCase{
+			Src: `schema test
+			struct S { a String b }
+			query q S`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UnterminatedParams": ErrCase{
+			Src: `schema test
+			query q(a String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UnclosedBlock": ErrCase{
+			Src: `schema test
+			struct S {
+				a String
+			query q S`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"SemanticAndSyntax": ErrCase{
+			Src: `schema test
+			struct S { a String a String }
+			struct S2 { a String }
+			struct S2 { b String }
+			query q(p Foo) S2`,
+			Errs: []ErrCode{
+				parser.ErrStructFieldRedecl,
+				parser.ErrTypeRedecl,
+				parser.ErrTypeUndef,
+			},
+		},
+	})
+}
+
+func TestMaxErrors(t *testing.T) {
+	source := src(`schema test
+	struct A { a $ }
+	struct B { b $ }
+	struct C { c $ }
+	query q String`)
+
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+	require.NotNil(t, pr)
+
+	// Unlimited
+	require.Error(t, pr.Parse(source))
+	require.Len(t, pr.Errors(), 3)
+
+	// Limited
+	pr.SetMaxErrors(2)
+	require.Error(t, pr.Parse(source))
+	require.Len(t, pr.Errors(), 2)
+	require.Nil(t, pr.SchemaModel())
+}
+
+// TestModGenerics tests generic type declarations and their instances
+// in SchemaModel
+func TestModGenerics(t *testing.T) {
+	src := `schema test
+	trait Named {
+		name String
+	}
+	struct File implements Named {
+		name String
+	}
+	struct Err {
+		msg String
+	}
+	# Page is a page of items
+	resolver Page<T> {
+		items []T
+		total Uint64
+		next ?Page<T>
+	}
+	union Res<T, E> {
+		T
+		E
+	}
+	struct Pair<A, B> {
+		a A
+		b B
+	}
+	alias Opt<T> = ?T
+	resolver Box<T> implements Named {
+		name String
+		v T
+	}
+	query files Page<File>
+	query res Res<Pair<File, String>, Err>
+	query opt(p Opt<String>) Opt<File>
+	query box Box<[]Page<File>>`
+
+	test(t, src, func(mod SchemaModel) {
+		require.Len(t, mod.QueryEndpoints, 4)
+		require.Len(t, mod.StructTypes, 3)
+		require.Len(t, mod.ResolverTypes, 2)
+		require.Len(t, mod.UnionTypes, 1)
+		require.Len(t, mod.AliasTypes, 2)
+
+		// Generic type declarations aren't types themselves
+		for _, name := range []string{"Page", "Res", "Pair", "Opt", "Box"} {
+			require.Nil(t, mod.FindTypeByDesignation(name))
+		}
+
+		// Page<File>
+		page := mod.FindTypeByDesignation("Page<File>")
+		require.IsType(t, &parser.TypeResolver{}, page)
+		pageFile := page.(*parser.TypeResolver)
+		require.Equal(t, "Page is a page of items", pageFile.Doc)
+		require.Equal(t, page, mod.QueryEndpoints[1].Type)
+		require.Len(t, pageFile.Properties, 3)
+		require.Equal(t, "Page<File>.items", pageFile.Properties[0].GraphNodeName())
+		require.Equal(t, "[]File", pageFile.Properties[0].Type.String())
+		require.Equal(
+			t,
+			mod.FindTypeByDesignation("File"),
+			pageFile.Properties[0].Type.TerminalType(),
+		)
+		require.Equal(t, "?Page<File>", pageFile.Properties[2].Type.String())
+		require.Equal(t, page, pageFile.Properties[2].Type.TerminalType())
+
+		// Res<Pair<File, String>, Err>
+		res := mod.FindTypeByDesignation("Res<Pair<File, String>, Err>")
+		require.IsType(t, &parser.TypeUnion{}, res)
+		require.Equal(t, res, mod.QueryEndpoints[3].Type)
+		pair := mod.FindTypeByDesignation("Pair<File, String>")
+		require.IsType(t, &parser.TypeStruct{}, pair)
+		require.Equal(t, []parser.Type{
+			pair,
+			mod.FindTypeByDesignation("Err"),
+		}, res.(*parser.TypeUnion).Types)
+
+		// Opt<File>
+		optFile := mod.FindTypeByDesignation("Opt<File>")
+		require.IsType(t, &parser.TypeAlias{}, optFile)
+		require.Equal(
+			t,
+			"?File",
+			optFile.(*parser.TypeAlias).AliasedType.String(),
+		)
+		require.Equal(t, optFile, mod.QueryEndpoints[2].Type)
+		require.Equal(
+			t,
+			mod.FindTypeByDesignation("Opt<String>"),
+			mod.QueryEndpoints[2].Parameters[0].Type,
+		)
+
+		// Box<[]Page<File>>
+		box := mod.FindTypeByDesignation("Box<[]Page<File>>")
+		require.IsType(t, &parser.TypeResolver{}, box)
+		require.Equal(t, []*parser.TypeTrait{
+			mod.FindTypeByDesignation("Named").(*parser.TypeTrait),
+		}, box.(*parser.TypeResolver).Implements)
+		require.Equal(t, box, mod.QueryEndpoints[0].Type)
+		v := box.(*parser.TypeResolver).Properties[1].Type
+		require.Equal(t, "[]Page<File>", v.String())
+		require.Equal(t, page, v.TerminalType())
+	})
+}
+
+// TestGenericErrs tests generic type declaration and instantiation errors
+func TestGenericErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"TypeParamRedecl": ErrCase{
+			Src: `schema test
+			struct S<T, T> { t T }
+			query q String`,
+			Errs: []ErrCode{parser.ErrTypeParamRedecl},
+		},
+		"TypeParamShadowsPrimitive": ErrCase{
+			Src: `schema test
+			struct P<String> { t String }
+			query q P<Bool>`,
+			Errs: []ErrCode{parser.ErrTypeParamRedecl},
+		},
+		"TypeParamShadowsType": ErrCase{
+			Src: `schema test
+			struct P<S> { t S }
+			struct S { s String }
+			query q P<Bool>`,
+			Errs: []ErrCode{parser.ErrTypeParamRedecl},
+		},
+		"TypeParamShadowsGeneric": ErrCase{
+			Src: `schema test
+			struct P<G> { t G }
+			struct G<T> { t T }
+			query q P<G<Bool>>`,
+			Errs: []ErrCode{parser.ErrTypeParamRedecl},
+		},
+		"TemplateErrsReportedOnce": ErrCase{
+			Src: `schema test
+			struct P<T> { v Foo  w T  x Bool<T> }
+			query q P<Int32>
+			query r P<String>`,
+			Errs: []ErrCode{
+				parser.ErrTypeUndef,
+				parser.ErrTypeNotGeneric,
+			},
+		},
+		"IllegalTypeParamName": ErrCase{
+			Src: `schema test
+			struct S<t> { t String }
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UnclosedTypeParams": ErrCase{
+			Src: `schema test
+			struct S<T { t T }
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UndefinedTypeInTemplate": ErrCase{
+			Src: `schema test
+			struct S<T> { t T  u Undefined }
+			query q String`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"GenericRedecl": ErrCase{
+			Src: `schema test
+			struct S<T> { t T }
+			resolver S<T> { t T }
+			query q String`,
+			Errs: []ErrCode{parser.ErrTypeRedecl},
+		},
+		"GenericTypeRedecl": ErrCase{
+			Src: `schema test
+			struct S<T> { t T }
+			enum S { foo bar }
+			query q String`,
+			Errs: []ErrCode{parser.ErrTypeRedecl},
+		},
+		"TypeNotGeneric": ErrCase{
+			Src: `schema test
+			struct S { s String }
+			query q S<String>`,
+			Errs: []ErrCode{parser.ErrTypeNotGeneric},
+		},
+		"TypeParamNotGeneric": ErrCase{
+			Src: `schema test
+			struct S<T> { t T<String> }
+			query q String`,
+			Errs: []ErrCode{parser.ErrTypeNotGeneric},
+		},
+		"MissingArgs": ErrCase{
+			Src: `schema test
+			struct S<T> { t T }
+			query q S`,
+			Errs: []ErrCode{parser.ErrGenericMissingArgs},
+		},
+		"TooManyArgs": ErrCase{
+			Src: `schema test
+			struct S<T> { t T }
+			query q S<String, Int32>`,
+			Errs: []ErrCode{parser.ErrGenericArgCount},
+		},
+		"TooFewArgs": ErrCase{
+			Src: `schema test
+			struct S<A, B> { a A  b B }
+			query q S<String>`,
+			Errs: []ErrCode{parser.ErrGenericArgCount},
+		},
+		"UndefinedArg": ErrCase{
+			Src: `schema test
+			struct S<T> { t T }
+			query q S<Undefined>`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"InfiniteRecursion": ErrCase{
+			Src: `schema test
+			struct S<T> { t ?S<[]T> }
+			query q S<String>`,
+			Errs: []ErrCode{parser.ErrGenericRecurs},
+		},
+		"StructRecursion": ErrCase{
+			Src: `schema test
+			struct S<T> { t T  s S<T> }
+			query q S<String>`,
+			Errs: []ErrCode{parser.ErrStructRecurs},
+		},
+		"AliasRecursion": ErrCase{
+			Src: `schema test
+			alias A<T> = A<T>
+			query q A<String>`,
+			Errs: []ErrCode{parser.ErrAliasRecurs},
+		},
+		"ImpureStructField": ErrCase{
+			Src: `schema test
+			resolver R { r String }
+			struct S<T> { t T }
+			query q S<R>`,
+			Errs: []ErrCode{parser.ErrStructFieldImpure},
+		},
+		"ImpureParam": ErrCase{
+			Src: `schema test
+			resolver R { r String }
+			resolver S<T> { t(p T) String }
+			query q S<R>`,
+			Errs: []ErrCode{parser.ErrParamImpure},
+		},
+		"OptionalChain": ErrCase{
+			Src: `schema test
+			alias Opt<T> = ?T
+			query q Opt<?String>`,
+			Errs: []ErrCode{parser.ErrTypeOptChain},
+		},
+		"RedundantUnionOptions": ErrCase{
+			Src: `schema test
+			union U<A, B> { A B }
+			query q U<String, String>`,
+			Errs: []ErrCode{parser.ErrUnionRedund},
+		},
+		"IncompleteTraitImpl": ErrCase{
+			Src: `schema test
+			trait T { name String }
+			resolver R<X> implements T { name X }
+			query q R<Int32>`,
+			Errs: []ErrCode{parser.ErrTraitImplPropMismatch},
+		},
+	})
+}
+
+// TestModMaps tests map types in SchemaModel
+func TestModMaps(t *testing.T) {
+	src := `schema test
+	enum E {
+		foo
+		bar
+	}
+	alias K = E
+	struct S {
+		counters [String]Uint64
+		byEnum ?[K][]?S
+	}
+	resolver R {
+		s(p [Int32]String) [E]R
+	}
+	query q S
+	query q2 R`
+
+	test(t, src, func(mod SchemaModel) {
+		tS := mod.FindTypeByDesignation("S").(*parser.TypeStruct)
+		tR := mod.FindTypeByDesignation("R").(*parser.TypeResolver)
+
+		// [String]Uint64
+		counters := tS.Fields[0].Type
+		require.IsType(t, &parser.TypeMap{}, counters)
+		require.Equal(t, "[String]Uint64", counters.String())
+		counterMap := counters.(*parser.TypeMap)
+		require.Equal(t, parser.TypeStdString{}, counterMap.KeyType)
+		require.Equal(t, parser.TypeStdUint64{}, counterMap.StoreType)
+		require.Equal(t, parser.TypeStdUint64{}, counterMap.Terminal)
+		require.Equal(t, counters, mod.FindTypeByDesignation("[String]Uint64"))
+		require.True(t, counters.IsPure())
+
+		// ?[K][]?S
+		byEnum := tS.Fields[1].Type
+		require.Equal(t, "?[K][]?S", byEnum.String())
+		require.Equal(t, tS, byEnum.TerminalType())
+		enumMap := byEnum.(*parser.TypeOptional).StoreType.(*parser.TypeMap)
+		require.Equal(t, mod.FindTypeByDesignation("K"), enumMap.KeyType)
+		require.Equal(t, "[]?S", enumMap.StoreType.String())
+		require.Equal(t, tS, enumMap.Terminal)
+
+		// [E]R
+		prop := tR.Properties[0]
+		require.Equal(t, "[E]R", prop.Type.String())
+		require.False(t, prop.Type.IsPure())
+		require.Equal(t, "[Int32]String", prop.Parameters[0].Type.String())
+		require.Equal(
+			t,
+			mod.FindTypeByDesignation("E"),
+			prop.Type.(*parser.TypeMap).KeyType,
+		)
+	})
+}
+
+// TestMapErrs tests map type errors
+func TestMapErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"KeyList": ErrCase{
+			Src: `schema test
+			query q [[]String]String`,
+			Errs: []ErrCode{parser.ErrMapKeyIllegal},
+		},
+		"KeyOptional": ErrCase{
+			Src: `schema test
+			query q [?String]String`,
+			Errs: []ErrCode{parser.ErrMapKeyIllegal},
+		},
+		"KeyNone": ErrCase{
+			Src: `schema test
+			query q [None]String`,
+			Errs: []ErrCode{parser.ErrMapKeyIllegal},
+		},
+		"KeyStruct": ErrCase{
+			Src: `schema test
+			struct S { s String }
+			query q [S]String`,
+			Errs: []ErrCode{parser.ErrMapKeyIllegal},
+		},
+		"KeyAliasOfStruct": ErrCase{
+			Src: `schema test
+			alias A = S
+			struct S { s String }
+			query q [A]String`,
+			Errs: []ErrCode{parser.ErrMapKeyIllegal},
+		},
+		"KeyUndefined": ErrCase{
+			Src: `schema test
+			query q [Undefined]String`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"MissingKeyType": ErrCase{
+			Src: `schema test
+			query q []]String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UnclosedKeyType": ErrCase{
+			Src: `schema test
+			query q [String String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"MissingStoreType": ErrCase{
+			Src: `schema test
+			query q [String]`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"ImpureParam": ErrCase{
+			Src: `schema test
+			resolver R { r String }
+			query q(p [String]R) String`,
+			Errs: []ErrCode{parser.ErrParamImpure},
+		},
+	})
+}
+
+// TestModParamDefaults tests parameter default values
+func TestModParamDefaults(t *testing.T) {
+	src := `schema test
+	enum Order {
+		asc
+		desc
+	}
+	alias Limit = Uint32
+	struct Filter {
+		name String
+		tags ?[]String
+	}
+	union Key {
+		String
+		Int64
+	}
+	query q(
+		limit Limit = 20
+		offset Int64 = -1
+		ratio Float64 = 2.5e-1
+		order Order = desc
+		after ?Time = null
+		since Time = "2019-01-01T00:00:00Z"
+		exact Bool = true
+		ids []Uint64 = [1, 2, 3]
+		filter Filter = {name: "x\"y", tags: []}
+		key Key = 42
+		none ?String
+	) String`
+
+	test(t, src, func(mod SchemaModel) {
+		params := mod.QueryEndpoints[0].Parameters
+		require.Len(t, params, 11)
+
+		check := func(i int, kind parser.ValueKind, literal string) {
+			require.NotNil(t, params[i].Default)
+			require.Equal(t, kind, params[i].Default.Kind)
+			require.Equal(t, literal, params[i].Default.Literal)
+		}
+		check(0, parser.ValueKindNumber, "20")
+		check(1, parser.ValueKindNumber, "-1")
+		check(2, parser.ValueKindNumber, "2.5e-1")
+		check(3, parser.ValueKindEnum, "desc")
+		check(4, parser.ValueKindNull, "null")
+		check(5, parser.ValueKindString, "2019-01-01T00:00:00Z")
+		check(6, parser.ValueKindBool, "true")
+		check(7, parser.ValueKindList, "")
+		check(8, parser.ValueKindStruct, "")
+		check(9, parser.ValueKindNumber, "42")
+		require.Nil(t, params[10].Default)
+
+		require.Len(t, params[7].Default.Items, 3)
+		require.Equal(t, "3", params[7].Default.Items[2].Literal)
+
+		filter := params[8].Default
+		require.Len(t, filter.Fields, 2)
+		require.Equal(t, "name", filter.Fields[0].Name)
+		require.Equal(t, `x"y`, filter.Fields[0].Value.Literal)
+		require.Equal(t, "tags", filter.Fields[1].Name)
+		require.Equal(t, parser.ValueKindList, filter.Fields[1].Value.Kind)
+		require.Len(t, filter.Fields[1].Value.Items, 0)
+
+		// JSON encoding
+		encoded := []string{
+			"20", "-1", "0.25", `"desc"`, "null",
+			`"2019-01-01T00:00:00Z"`, "true", "[1,2,3]",
+			`{"name":"x\"y","tags":[]}`, "42",
+		}
+		for i, expected := range encoded {
+			actual, err := json.Marshal(params[i].Default)
+			require.NoError(t, err)
+			require.Equal(t, expected, string(actual))
+		}
+	})
+}
+
+// TestParamDefaultErrs tests parameter default value errors
+func TestParamDefaultErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"StringForInt": ErrCase{
+			Src: `schema test
+			query q(p Int32 = "1") String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"ByteOutOfRange": ErrCase{
+			Src: `schema test
+			query q(p Byte = 256) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"NegativeUint": ErrCase{
+			Src: `schema test
+			query q(p Uint32 = -1) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"FloatForInt": ErrCase{
+			Src: `schema test
+			query q(p Int64 = 1.5) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"NullForNonOptional": ErrCase{
+			Src: `schema test
+			query q(p String = null) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"IllegalTime": ErrCase{
+			Src: `schema test
+			query q(p Time = "yesterday") String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"UndefinedEnumValue": ErrCase{
+			Src: `schema test
+			enum E { foo bar }
+			query q(p E = baz) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"ListItemMismatch": ErrCase{
+			Src: `schema test
+			query q(p []Bool = [true, 1]) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"StructUnknownField": ErrCase{
+			Src: `schema test
+			struct S { a String }
+			query q(p S = {a: "", b: ""}) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"StructMissingField": ErrCase{
+			Src: `schema test
+			struct S { a String b ?String }
+			query q(p S = {b: ""}) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"UnionNoMatchingOption": ErrCase{
+			Src: `schema test
+			union U { String Bool }
+			query q(p U = 1) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"Map": ErrCase{
+			Src: `schema test
+			query q(p [String]String = {}) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"StructFieldRedecl": ErrCase{
+			Src: `schema test
+			struct S { a String }
+			query q(p S = {a: "" a: ""}) String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"MissingValue": ErrCase{
+			Src: `schema test
+			query q(p String =) String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"UnclosedList": ErrCase{
+			Src: `schema test
+			query q(p []String = ["a") String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"MissingColon": ErrCase{
+			Src: `schema test
+			struct S { a String }
+			query q(p S = {a ""}) String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestModDeprecations tests deprecation markers in SchemaModel
+func TestModDeprecations(t *testing.T) {
+	src := `schema test
+	# Legacy is a legacy type
+	deprecated("replaced by struct Info", "Info")
+	struct Legacy {
+		deprecated("unused")
+		name String
+		deprecated String
+	}
+	struct Info { name String }
+	resolver R {
+		deprecated(
+			"use info instead",
+			"R.info"
+		)
+		legacy Legacy
+		info Info
+		deprecated(x Int32) Bool
+	}
+	trait T {
+		deprecated("gone") t String
+	}
+	deprecated("use r2")
+	query r R
+	query r2 R
+	deprecated("use newMut", "newMut")
+	mutation oldMut Legacy
+	mutation newMut Info
+	deprecated("no longer supported")
+	subscription sub String`
+
+	test(t, src, func(mod SchemaModel) {
+		tLegacy := mod.FindTypeByDesignation("Legacy").(*parser.TypeStruct)
+		require.Equal(t, "Legacy is a legacy type", tLegacy.Doc)
+		require.NotNil(t, tLegacy.Deprecated)
+		require.Equal(t, "replaced by struct Info", tLegacy.Deprecated.Reason)
+		require.Equal(t, "Info", tLegacy.Deprecated.Replacement)
+
+		require.Len(t, tLegacy.Fields, 2)
+		require.NotNil(t, tLegacy.Fields[0].Deprecated)
+		require.Equal(t, "unused", tLegacy.Fields[0].Deprecated.Reason)
+		require.Equal(t, "", tLegacy.Fields[0].Deprecated.Replacement)
+		require.Equal(t, "deprecated", tLegacy.Fields[1].Name)
+		require.Nil(t, tLegacy.Fields[1].Deprecated)
+
+		require.Nil(t, mod.FindTypeByDesignation("Info").(*parser.TypeStruct).
+			Deprecated)
+
+		tR := mod.FindTypeByDesignation("R").(*parser.TypeResolver)
+		require.Len(t, tR.Properties, 3)
+		require.NotNil(t, tR.Properties[0].Deprecated)
+		require.Equal(t, "R.info", tR.Properties[0].Deprecated.Replacement)
+		require.Nil(t, tR.Properties[1].Deprecated)
+		require.Equal(t, "deprecated", tR.Properties[2].Name)
+		require.Len(t, tR.Properties[2].Parameters, 1)
+		require.Nil(t, tR.Properties[2].Deprecated)
+
+		tT := mod.FindTypeByDesignation("T").(*parser.TypeTrait)
+		require.NotNil(t, tT.Properties[0].Deprecated)
+
+		require.Len(t, mod.QueryEndpoints, 2)
+		require.NotNil(t, mod.QueryEndpoints[0].Deprecated)
+		require.Nil(t, mod.QueryEndpoints[1].Deprecated)
+		require.Equal(t, "oldMut", mod.Mutations[1].Name)
+		require.NotNil(t, mod.Mutations[1].Deprecated)
+		require.Nil(t, mod.Mutations[0].Deprecated)
+		require.NotNil(t, mod.Subscriptions[0].Deprecated)
+
+		// JSON model
+		encoded, err := mod.MarshalJSON()
+		require.NoError(t, err)
+		decoded := parser.JSONSchemaModel{}
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, &parser.JSONModelDeprecation{
+			Reason:      "replaced by struct Info",
+			Replacement: "Info",
+		}, decoded.StructTypes[1].Deprecated)
+		require.Equal(t, "unused", decoded.StructTypes[1].Fields[0].
+			Deprecated.Reason)
+		require.Nil(t, decoded.StructTypes[0].Deprecated)
+		require.Equal(t, "use r2", decoded.QueryEndpoints[0].
+			Deprecated.Reason)
+		require.Nil(t, decoded.QueryEndpoints[1].Deprecated)
+	})
+}
+
+// TestDeprecatedTypeRefWarnings tests warnings about deprecated types
+// referenced by non-deprecated endpoints
+func TestDeprecatedTypeRefWarnings(t *testing.T) {
+	source := src(`schema test
+	deprecated("replaced by New")
+	struct Old { s String }
+	struct New { s String }
+	deprecated("replaced by String")
+	alias OldID = String
+	alias Wrapped = []Old
+	query q1(id OldID) ?[String]Old
+	query q2 Wrapped
+	query q3 New
+	deprecated("use q3")
+	query q4(id OldID) Old`)
+
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+	require.NoError(t, pr.Parse(source))
+	require.NotNil(t, pr.SchemaModel())
+
+	warnings := pr.Warnings()
+	messages := make([]string, len(warnings))
+	for i, warning := range warnings {
+		require.Equal(t, parser.ErrDeprecatedTypeRef, warning.Code())
+		messages[i] = warning.Message()
+	}
+	require.Equal(t, []string{
+		"endpoint q1 references deprecated type OldID",
+		"endpoint q1 references deprecated type Old",
+		"endpoint q2 references deprecated type Old",
+	}, messages)
+}
+
+// TestDeprecationErrs tests deprecation marker errors
+func TestDeprecationErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"MissingReason": ErrCase{
+			Src: `schema test
+			deprecated()
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"EmptyReason": ErrCase{
+			Src: `schema test
+			deprecated("")
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"TooManyArgs": ErrCase{
+			Src: `schema test
+			deprecated("a", "b", "c")
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"MissingSeparator": ErrCase{
+			Src: `schema test
+			deprecated("a" "b")
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"Unclosed": ErrCase{
+			Src: `schema test
+			deprecated("a"
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"NoDeclaration": ErrCase{
+			Src: `schema test
+			struct S {
+				s String
+				deprecated("a")
+			}
+			query q S`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"Import": ErrCase{
+			Src: `schema test
+			deprecated("a")
+			import "b.gapi"
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestModAnnotations tests annotations in SchemaModel
+func TestModAnnotations(t *testing.T) {
+	src := `schema test
+	# E is an enum
+	@flags
+	enum E {
+		@legacy("v1")
+		foo
+		bar
+	}
+	@table("users", {shards: 4})
+	@cache(60)
+	struct S {
+		@index
+		@unique()
+		id String
+		@column({name: "x"})
+		x Int32
+	}
+	resolver R {
+		@cost(2.5)
+		r(@range(1, [10, 20]) limit Int32 = 1) String
+	}
+	deprecated("use q2")
+	@auth(admin)
+	query q S
+	query q2(@inject r String) String`
+
+	test(t, src, func(mod SchemaModel) {
+		tE := mod.FindTypeByDesignation("E").(*parser.TypeEnum)
+		require.Equal(t, "E is an enum", tE.Doc)
+		require.Len(t, tE.Annotations, 1)
+		require.Equal(t, "flags", tE.Annotations[0].Name)
+		require.Len(t, tE.Annotations[0].Args, 0)
+		require.Len(t, tE.Values[0].Annotations, 1)
+		require.Equal(t, "legacy", tE.Values[0].Annotations[0].Name)
+		require.Equal(t, "v1", tE.Values[0].Annotations[0].Args[0].Literal)
+		require.Len(t, tE.Values[1].Annotations, 0)
+
+		tS := mod.FindTypeByDesignation("S").(*parser.TypeStruct)
+		require.Len(t, tS.Annotations, 2)
+		require.Equal(t, "table", tS.Annotations[0].Name)
+		require.Len(t, tS.Annotations[0].Args, 2)
+		require.Equal(
+			t,
+			parser.ValueKindStruct,
+			tS.Annotations[0].Args[1].Kind,
+		)
+		require.Equal(t, "cache", tS.Annotations[1].Name)
+		require.Len(t, tS.Fields[0].Annotations, 2)
+		require.Equal(t, "index", tS.Fields[0].Annotations[0].Name)
+		require.Equal(t, "unique", tS.Fields[0].Annotations[1].Name)
+		require.Len(t, tS.Fields[1].Annotations, 1)
+		require.Equal(
+			t,
+			parser.ValueKindStruct,
+			tS.Fields[1].Annotations[0].Args[0].Kind,
+		)
+
+		tR := mod.FindTypeByDesignation("R").(*parser.TypeResolver)
+		require.Len(t, tR.Properties[0].Annotations, 1)
+		param := tR.Properties[0].Parameters[0]
+		require.Len(t, param.Annotations, 1)
+		require.Equal(t, "range", param.Annotations[0].Name)
+		require.Len(t, param.Annotations[0].Args, 2)
+		require.NotNil(t, param.Default)
+
+		require.NotNil(t, mod.QueryEndpoints[0].Deprecated)
+		require.Len(t, mod.QueryEndpoints[0].Annotations, 1)
+		require.Equal(
+			t,
+			parser.ValueKindEnum,
+			mod.QueryEndpoints[0].Annotations[0].Args[0].Kind,
+		)
+		require.Equal(
+			t,
+			"inject",
+			mod.QueryEndpoints[1].Parameters[0].Annotations[0].Name,
+		)
+
+		// JSON model
+		encoded, err := mod.MarshalJSON()
+		require.NoError(t, err)
+		decoded := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		structs := decoded["struct-types"].([]interface{})
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				"name": "table",
+				"args": []interface{}{
+					"users",
+					map[string]interface{}{"shards": float64(4)},
+				},
+			},
+			map[string]interface{}{
+				"name": "cache",
+				"args": []interface{}{float64(60)},
+			},
+		}, structs[0].(map[string]interface{})["annotations"])
+		enums := decoded["enum-types"].([]interface{})
+		require.Equal(t, map[string]interface{}{
+			"foo": []interface{}{
+				map[string]interface{}{
+					"name": "legacy",
+					"args": []interface{}{"v1"},
+				},
+			},
+		}, enums[0].(map[string]interface{})["value-annotations"])
+	})
+}
+
+// TestAnnotationErrs tests annotation errors
+func TestAnnotationErrs(t *testing.T) {
+	register := func(pr *parser.Parser) {
+		for _, schema := range []parser.AnnotationSchema{
+			{Name: "index"},
+			{
+				Name: "cost",
+				Params: []parser.AnnotationParam{
+					{Name: "value", Type: parser.TypeStdFloat64{}},
+				},
+			},
+			{
+				Name: "column",
+				Params: []parser.AnnotationParam{
+					{Name: "name", Type: parser.TypeStdString{}},
+					{
+						Name:     "nullable",
+						Type:     parser.TypeStdBool{},
+						Optional: true,
+					},
+				},
+				Repeatable: true,
+			},
+		} {
+			if err := pr.RegisterAnnotation(schema); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	testErrs(t, map[string]ErrCase{
+		"Unknown": ErrCase{
+			Src: `schema test
+			@unknown
+			query q String`,
+			Errs:  []ErrCode{parser.ErrAnnotationIllegal},
+			Setup: register,
+		},
+		"UnknownField": ErrCase{
+			Src: `schema test
+			struct S {
+				@index
+				@unknown
+				s String
+			}
+			query q S`,
+			Errs:  []ErrCode{parser.ErrAnnotationIllegal},
+			Setup: register,
+		},
+		"MissingArgs": ErrCase{
+			Src: `schema test
+			@cost
+			query q String`,
+			Errs:  []ErrCode{parser.ErrAnnotationIllegal},
+			Setup: register,
+		},
+		"TooManyArgs": ErrCase{
+			Src: `schema test
+			@column("a", true, 1)
+			query q String`,
+			Errs:  []ErrCode{parser.ErrAnnotationIllegal},
+			Setup: register,
+		},
+		"ArgTypeMismatch": ErrCase{
+			Src: `schema test
+			@column("a", 1)
+			query q String`,
+			Errs:  []ErrCode{parser.ErrAnnotationIllegal},
+			Setup: register,
+		},
+		"NonRepeatable": ErrCase{
+			Src: `schema test
+			struct S {
+				@index @index
+				s String
+			}
+			query q S`,
+			Errs:  []ErrCode{parser.ErrAnnotationIllegal},
+			Setup: register,
+		},
+		"IllegalName": ErrCase{
+			Src: `schema test
+			@Index
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"SpaceAfterInitiator": ErrCase{
+			Src: `schema test
+			@ index
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"MissingSeparator": ErrCase{
+			Src: `schema test
+			@column("a" true)
+			query q String`,
+			Errs:  []ErrCode{parser.ErrSyntax},
+			Setup: register,
+		},
+		"UnclosedArgs": ErrCase{
+			Src: `schema test
+			@index(1
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"NoDeclaration": ErrCase{
+			Src: `schema test
+			struct S {
+				s String
+				@index
+			}
+			query q S`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"Import": ErrCase{
+			Src: `schema test
+			@index
+			import "b.gapi"
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestRegisterAnnotationErrs tests annotation registration errors
+func TestRegisterAnnotationErrs(t *testing.T) {
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+
+	require.NoError(t, pr.RegisterAnnotation(parser.AnnotationSchema{
+		Name: "index",
+	}))
+
+	for name, schema := range map[string]parser.AnnotationSchema{
+		"Redecl":      {Name: "index"},
+		"IllegalName": {Name: "Index"},
+		"UnnamedParam": {
+			Name:   "a",
+			Params: []parser.AnnotationParam{{Type: parser.TypeStdBool{}}},
+		},
+		"UntypedParam": {
+			Name:   "b",
+			Params: []parser.AnnotationParam{{Name: "p"}},
+		},
+		"RequiredAfterOptional": {
+			Name: "c",
+			Params: []parser.AnnotationParam{
+				{Name: "p1", Type: parser.TypeStdBool{}, Optional: true},
+				{Name: "p2", Type: parser.TypeStdBool{}},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, pr.RegisterAnnotation(schema))
+		})
+	}
+}
+
+// TestModConstraints tests value constraints in SchemaModel
+func TestModConstraints(t *testing.T) {
+	src := `schema test
+	alias Name = String
+	struct User {
+		name Name where minLength(3), maxLength(64)
+		email ?String where pattern("^[^@]+@[^@]+$")
+		age Uint32 where min(18), max(130)
+		tags []String where nonEmpty
+		where Int32
+		nonEmpty Bool
+	}
+	query users(
+		limit Int32 where min(1), max(100) = 20,
+		name String where nonEmpty,
+		nonEmpty Bool,
+	) []User`
+
+	test(t, src, func(mod SchemaModel) {
+		tUser := mod.FindTypeByDesignation("User").(*parser.TypeStruct)
+		require.Len(t, tUser.Fields, 6)
+
+		check := func(
+			cs []*parser.Constraint,
+			kind parser.ConstraintKind,
+			literal string,
+		) {
+			for _, c := range cs {
+				if c.Kind != kind {
+					continue
+				}
+				if literal == "" {
+					require.Nil(t, c.Value)
+				} else {
+					require.Equal(t, literal, c.Value.Literal)
+				}
+				return
+			}
+			t.Fatalf("constraint %s not found", kind)
+		}
+
+		require.Len(t, tUser.Fields[0].Constraints, 2)
+		check(tUser.Fields[0].Constraints, parser.ConstraintMinLength, "3")
+		check(tUser.Fields[0].Constraints, parser.ConstraintMaxLength, "64")
+		check(
+			tUser.Fields[1].Constraints,
+			parser.ConstraintPattern,
+			"^[^@]+@[^@]+$",
+		)
+		check(tUser.Fields[2].Constraints, parser.ConstraintMin, "18")
+		check(tUser.Fields[2].Constraints, parser.ConstraintMax, "130")
+		check(tUser.Fields[3].Constraints, parser.ConstraintNonEmpty, "")
+		require.Equal(t, "where", tUser.Fields[4].Name)
+		require.Len(t, tUser.Fields[4].Constraints, 0)
+		require.Equal(t, "nonEmpty", tUser.Fields[5].Name)
+
+		params := mod.QueryEndpoints[0].Parameters
+		require.Len(t, params, 3)
+		require.Len(t, params[0].Constraints, 2)
+		check(params[0].Constraints, parser.ConstraintMax, "100")
+		require.Equal(t, "20", params[0].Default.Literal)
+		check(params[1].Constraints, parser.ConstraintNonEmpty, "")
+		require.Equal(t, "nonEmpty", params[2].Name)
+		require.Len(t, params[2].Constraints, 0)
+
+		// JSON model
+		encoded, err := mod.MarshalJSON()
+		require.NoError(t, err)
+		decoded := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		structs := decoded["struct-types"].([]interface{})
+		fields := structs[0].(map[string]interface{})["fields"].([]interface{})
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"kind": "minLength", "value": float64(3)},
+			map[string]interface{}{"kind": "maxLength", "value": float64(64)},
+		}, fields[0].(map[string]interface{})["constraints"])
+		tags := fields[3].(map[string]interface{})
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"kind": "nonEmpty"},
+		}, tags["constraints"])
+	})
+}
+
+// TestConstraintErrs tests value constraint errors
+func TestConstraintErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"MinOnString": ErrCase{
+			Src: `schema test
+			query q(p String where min(1)) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"LengthOnNumber": ErrCase{
+			Src: `schema test
+			struct S { s Int32 where maxLength(3) }
+			query q S`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"PatternOnList": ErrCase{
+			Src: `schema test
+			query q(p []String where pattern("a")) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"NonEmptyOnEnum": ErrCase{
+			Src: `schema test
+			enum E { a b }
+			query q(p E where nonEmpty) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"IllegalPattern": ErrCase{
+			Src: `schema test
+			query q(p String where pattern("(")) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"MinOutOfRange": ErrCase{
+			Src: `schema test
+			query q(p Byte where min(300)) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"NegativeLength": ErrCase{
+			Src: `schema test
+			query q(p String where minLength(-1)) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"MinExceedsMax": ErrCase{
+			Src: `schema test
+			query q(p Int32 where min(10), max(5)) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"Redecl": ErrCase{
+			Src: `schema test
+			query q(p Int32 where min(1), min(2)) String`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"UnknownConstraint": ErrCase{
+			Src: `schema test
+			query q(p Int32 where between(1)) String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"MissingArgument": ErrCase{
+			Src: `schema test
+			query q(p Int32 where min) String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestModExtendedPrimitives tests the extended set of primitive types
+func TestModExtendedPrimitives(t *testing.T) {
+	src := `schema test
+	struct S {
+		i8 Int8
+		i16 Int16
+		u8 Uint8
+		u16 Uint16
+		f32 Float32
+		duration Duration
+		date Date
+		uuid UUID
+		decimal Decimal
+	}
+	query q(
+		i8 Int8 = -128
+		i16 Int16 = 32767
+		u8 Uint8 = 255
+		u16 Uint16 = 65535
+		f32 Float32 = 1.5
+		duration Duration = "1h30m"
+		date Date = "2019-02-28"
+		uuid UUID = "123e4567-e89b-12d3-a456-426655440000"
+		decimal Decimal = -12.50
+		key [UUID]Decimal
+	) S`
+
+	test(t, src, func(mod SchemaModel) {
+		tS := mod.FindTypeByDesignation("S").(*parser.TypeStruct)
+		expected := []struct {
+			Type parser.Type
+			ID   parser.TypeID
+		}{
+			{parser.TypeStdInt8{}, parser.TypeIDPrimitiveInt8},
+			{parser.TypeStdInt16{}, parser.TypeIDPrimitiveInt16},
+			{parser.TypeStdUint8{}, parser.TypeIDPrimitiveUint8},
+			{parser.TypeStdUint16{}, parser.TypeIDPrimitiveUint16},
+			{parser.TypeStdFloat32{}, parser.TypeIDPrimitiveFloat32},
+			{parser.TypeStdDuration{}, parser.TypeIDPrimitiveDuration},
+			{parser.TypeStdDate{}, parser.TypeIDPrimitiveDate},
+			{parser.TypeStdUUID{}, parser.TypeIDPrimitiveUUID},
+			{parser.TypeStdDecimal{}, parser.TypeIDPrimitiveDecimal},
+		}
+		require.Len(t, tS.Fields, len(expected))
+		for i, exp := range expected {
+			require.Equal(t, exp.Type, tS.Fields[i].Type)
+			require.Equal(t, exp.ID, tS.Fields[i].Type.TypeID())
+			require.True(t, exp.ID < parser.TypeIDUserTypeOffset)
+			require.True(t, tS.Fields[i].Type.IsPure())
+		}
+		require.Equal(
+			t,
+			"[UUID]Decimal",
+			mod.QueryEndpoints[0].Parameters[9].Type.String(),
+		)
+	})
+}
+
+// TestExtendedPrimitiveDefaultErrs tests default values
+// of the extended primitive types
+func TestExtendedPrimitiveDefaultErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"Int8OutOfRange": ErrCase{
+			Src: `schema test
+			query q(p Int8 = 128) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"Uint16Negative": ErrCase{
+			Src: `schema test
+			query q(p Uint16 = -1) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"Float32OutOfRange": ErrCase{
+			Src: `schema test
+			query q(p Float32 = 1e39) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"IllegalDuration": ErrCase{
+			Src: `schema test
+			query q(p Duration = "forever") String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"IllegalDate": ErrCase{
+			Src: `schema test
+			query q(p Date = "2019-02-30") String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"IllegalUUID": ErrCase{
+			Src: `schema test
+			query q(p UUID = "123") String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"DecimalExponent": ErrCase{
+			Src: `schema test
+			query q(p Decimal = 1e3) String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+	})
+}
+
+// TestModScalars tests custom scalar type declarations
+func TestModScalars(t *testing.T) {
+	src := `schema test
+	alias Text = String
+
+	# Email is an e-mail address
+	scalar Email Text where pattern("^[^@]+@[^@]+$")
+	scalar Cents Int64 where min(0)
+	scalar FileID UUID
+
+	struct User {
+		email Email
+		balance Cents
+		files [FileID]String
+	}
+	query user(email Email = "foo@bar.baz") ?User`
+
+	test(t, src, func(mod SchemaModel) {
+		require.Len(t, mod.ScalarTypes, 3)
+
+		tEmail := mod.FindTypeByDesignation("Email").(*parser.TypeScalar)
+		require.Equal(t, "Email", tEmail.Name)
+		require.Equal(t, "Email is an e-mail address", tEmail.Doc)
+		require.Equal(t, mod.FindTypeByDesignation("Text"), tEmail.BaseType)
+		require.Len(t, tEmail.Constraints, 1)
+		require.Equal(t, parser.ConstraintPattern, tEmail.Constraints[0].Kind)
+		require.True(t, tEmail.IsPure())
+		require.True(t, tEmail.TypeID() >= parser.TypeIDUserTypeOffset)
+
+		tCents := mod.FindTypeByDesignation("Cents").(*parser.TypeScalar)
+		require.Equal(t, parser.TypeStdInt64{}, tCents.BaseType)
+
+		tFileID := mod.FindTypeByDesignation("FileID").(*parser.TypeScalar)
+		require.Equal(t, parser.TypeStdUUID{}, tFileID.BaseType)
+		require.Len(t, tFileID.Constraints, 0)
+
+		tUser := mod.FindTypeByDesignation("User").(*parser.TypeStruct)
+		require.Equal(t, tEmail, tUser.Fields[0].Type)
+		require.Equal(t, tFileID, tUser.Fields[2].Type.(*parser.TypeMap).KeyType)
+
+		param := mod.QueryEndpoints[0].Parameters[0]
+		require.Equal(t, tEmail, param.Type)
+		require.Equal(t, "foo@bar.baz", param.Default.Literal)
+
+		// JSON model
+		encoded, err := mod.MarshalJSON()
+		require.NoError(t, err)
+		decoded := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		scalars := decoded["scalar-types"].([]interface{})
+		require.Len(t, scalars, 3)
+		email := scalars[0].(map[string]interface{})
+		require.Equal(t, "Email", email["name"])
+		require.Equal(t, float64(tEmail.ID), email["id"])
+		require.Equal(t, float64(tEmail.BaseType.TypeID()), email["base-type-id"])
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"kind": "pattern", "value": "^[^@]+@[^@]+$"},
+		}, email["constraints"])
+	})
+}
+
+// TestScalarErrs tests scalar type declaration errors
+func TestScalarErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"NoneBase": ErrCase{
+			Src: `schema test
+			scalar S None
+			query q S`,
+			Errs: []ErrCode{parser.ErrScalarBaseIllegal},
+		},
+		"ListBase": ErrCase{
+			Src: `schema test
+			scalar S []String
+			query q S`,
+			Errs: []ErrCode{parser.ErrScalarBaseIllegal},
+		},
+		"EnumBase": ErrCase{
+			Src: `schema test
+			enum E { a b }
+			scalar S E
+			query q S`,
+			Errs: []ErrCode{parser.ErrScalarBaseIllegal},
+		},
+		"ScalarBase": ErrCase{
+			Src: `schema test
+			scalar A String
+			scalar B A
+			query q B`,
+			Errs: []ErrCode{parser.ErrScalarBaseIllegal},
+		},
+		"UndefinedBase": ErrCase{
+			Src: `schema test
+			scalar S Undefined
+			query q S`,
+			Errs: []ErrCode{parser.ErrTypeUndef},
+		},
+		"Redecl": ErrCase{
+			Src: `schema test
+			scalar S String
+			scalar S Int32
+			query q S`,
+			Errs: []ErrCode{parser.ErrTypeRedecl},
+		},
+		"InapplicableConstraint": ErrCase{
+			Src: `schema test
+			scalar S Bool where min(1)
+			query q S`,
+			Errs: []ErrCode{parser.ErrConstraintIllegal},
+		},
+		"DefaultMismatch": ErrCase{
+			Src: `schema test
+			scalar S Int32
+			query q(p S = "x") String`,
+			Errs: []ErrCode{parser.ErrParamDefaultMismatch},
+		},
+		"LowerCaseName": ErrCase{
+			Src: `schema test
+			scalar s String
+			query q String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestComments tests non-documentation comments
+func TestComments(t *testing.T) {
+	src := `// line comment
+	schema test /* block comment */
+
+	/*
+		enum Disabled {
+			a
+		}
+	*/
+
+	# Doc
+	// not part of the documentation
+	enum E {
+		a // first
+		/* second */ b
+	}
+
+	struct S {
+		// url isn't a comment
+		url String // "http://localhost"
+	}
+
+	query q(
+		e E = a /* default */,
+	) S // }`
+
+	test(t, src, func(mod SchemaModel) {
+		require.Nil(t, mod.FindTypeByDesignation("Disabled"))
+
+		tE := mod.FindTypeByDesignation("E").(*parser.TypeEnum)
+		require.Equal(t, "Doc", tE.Doc)
+		require.Len(t, tE.Values, 2)
+		require.Equal(t, "a", tE.Values[0].Name)
+		require.Equal(t, "b", tE.Values[1].Name)
+
+		tS := mod.FindTypeByDesignation("S").(*parser.TypeStruct)
+		require.Len(t, tS.Fields, 1)
+		require.Equal(t, "url", tS.Fields[0].Name)
+
+		require.Equal(t, "a", mod.QueryEndpoints[0].Parameters[0].Default.Literal)
+	})
+}
+
+// TestCommentErrs tests comment syntax errors
+func TestCommentErrs(t *testing.T) {
+	testErrs(t, map[string]ErrCase{
+		"UnterminatedBlockComment": ErrCase{
+			Src: `schema test
+			query q String
+			/* unterminated`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+		"SingleSlash": ErrCase{
+			Src: `schema test
+			query q / String`,
+			Errs: []ErrCode{parser.ErrSyntax},
+		},
+	})
+}
+
+// TestWarnings tests compiler warnings
+func TestWarnings(t *testing.T) {
+	type Warning struct {
+		Code    ErrCode
+		Message string
+	}
+	test := func(t *testing.T, source string, expected []Warning) {
+		pr, err := parser.NewParser()
+		require.NoError(t, err)
+		require.NoError(t, pr.Parse(src(source)))
+		require.NotNil(t, pr.SchemaModel())
+
+		warnings := pr.Warnings()
+		actual := make([]Warning, len(warnings))
+		for i, warning := range warnings {
+			require.Equal(t, parser.SeverityWarning, warning.Severity())
+			actual[i] = Warning{warning.Code(), warning.Message()}
+		}
+		require.Equal(t, expected, actual)
+	}
+
+	t.Run("None", func(t *testing.T) {
+		test(t, `schema test
+		alias ErrA = None
+		union U { ErrA String }
+		enum E { a b }
+		trait T { id String }
+		struct S implements T { id String e E }
+		query q1 T
+		query q2 U`, []Warning{})
+	})
+
+	t.Run("UnusedTypes", func(t *testing.T) {
+		test(t, `schema test
+		struct Used { s ?[]Nested }
+		struct Nested { s String }
+		struct Unused { s String }
+		enum UnusedEnum { a b }
+		query q Used`, []Warning{
+			{
+				parser.ErrTypeUnused,
+				"type Unused isn't reachable from any endpoint",
+			},
+			{
+				parser.ErrTypeUnused,
+				"type UnusedEnum isn't reachable from any endpoint",
+			},
+		})
+	})
+
+	t.Run("AliasChain", func(t *testing.T) {
+		test(t, `schema test
+		alias A = String
+		alias B = A
+		query q B`, []Warning{
+			{
+				parser.ErrAliasChain,
+				"alias B aliases alias A (consider aliasing String directly)",
+			},
+		})
+	})
+
+	t.Run("SingleValEnum", func(t *testing.T) {
+		test(t, `schema test
+		enum E { only }
+		query q E`, []Warning{
+			{
+				parser.ErrEnumSingleVal,
+				"enum E has only a single value (only)",
+			},
+		})
+	})
+
+	t.Run("NoneUnion", func(t *testing.T) {
+		test(t, `schema test
+		alias ErrA = None
+		alias ErrB = None
+		union U { ErrA ErrB }
+		query q U`, []Warning{
+			{
+				parser.ErrUnionNoneOpts,
+				"all option types of union U are aliases of None " +
+					"(consider using an enum instead)",
+			},
+		})
+	})
+}
+
+// TestErrSeverity tests the severity of compiler errors
+func TestErrSeverity(t *testing.T) {
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+	require.Error(t, pr.Parse(src(`schema test
+	enum E { a b }
+	query q E
+	query q E`)))
+	errs := pr.Errors()
+	require.Len(t, errs, 1)
+	require.Equal(t, parser.SeverityError, errs[0].Severity())
+	require.Len(t, pr.Warnings(), 0)
+}
+
+// TestFragments tests the fragment trees of parsed schema files
+func TestFragments(t *testing.T) {
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+	require.Nil(t, pr.Fragments())
+	require.NoError(t, pr.Parse(src(`schema test
+	// first
+	struct S {
+		s String /* second */
+	}
+	resolver R {
+		r(
+			// third
+			a Int32 // fourth
+		) String
+	}
+	query q(s S) R`)))
+
+	frags := pr.Fragments()
+	require.Len(t, frags, 1)
+	require.Equal(t, parser.FragScmFile, frags[0].FragID())
+
+	// Ensure comments are inserted into the innermost constructs
+	// containing them in order of appearance
+	type comment struct {
+		Src    string
+		Parent parser.FragID
+	}
+	comments := []comment{}
+	var walk func(parent parser.Fragment)
+	walk = func(parent parser.Fragment) {
+		for _, element := range parent.Elements() {
+			if element.FragID() == parser.FragTkComment {
+				comments = append(comments, comment{
+					element.Src(),
+					parent.FragID(),
+				})
+			}
+			walk(element)
+		}
+	}
+	walk(frags[0])
+	require.Equal(t, []comment{
+		{"// first", parser.FragScmFile},
+		{"/* second */", parser.FragStrFields},
+		{"// third", parser.FragParams},
+		{"// fourth", parser.FragParams},
+	}, comments)
+}
+
+// TestIDLock tests keeping identifiers stable using ID locks
+func TestIDLock(t *testing.T) {
+	parse := func(lock *parser.IDLock, source string) (
+		SchemaModel,
+		*parser.IDLock,
+	) {
+		pr, err := parser.NewParser()
+		require.NoError(t, err)
+		pr.SetIDLock(lock)
+		require.Nil(t, pr.IDLock())
+		require.NoError(t, pr.Parse(src(source)))
+		mod := pr.SchemaModel()
+		verifyModel(t, mod)
+		return mod, pr.IDLock()
+	}
+
+	modA, lockA := parse(nil, `schema test
+	struct A {
+		a String
+	}
+	struct B {
+		b String
+	}
+	resolver R {
+		r(p String) A
+	}
+	query q(x String) B
+	query r R`)
+	require.NotNil(t, lockA)
+	require.Equal(t, modA.FindTypeByDesignation("A").TypeID(), lockA.Types["A"])
+	require.Equal(t, modA.FindTypeByDesignation("B").TypeID(), lockA.Types["B"])
+	require.Contains(t, lockA.GraphNodes, "A.a")
+	require.Contains(t, lockA.GraphNodes, "q")
+	require.Contains(t, lockA.Params, "R.r.p")
+	require.Contains(t, lockA.Params, "q.x")
+
+	// Reorder the declarations, remove B and declare C
+	modB, lockB := parse(lockA, `schema test
+	struct C {
+		c String
+	}
+	resolver R {
+		r(p String) A
+	}
+	struct A {
+		a String
+	}
+	query r R
+	query q(y String, x String) C`)
+
+	require.Equal(t, lockA.Types["A"], modB.FindTypeByDesignation("A").TypeID())
+	require.Equal(t, lockA.Types["R"], modB.FindTypeByDesignation("R").TypeID())
+
+	// Ensure new identifiers don't reuse pinned ones
+	idC := modB.FindTypeByDesignation("C").TypeID()
+	for name, id := range lockA.Types {
+		require.NotEqual(t, id, idC, "type C reuses the ID of %s", name)
+	}
+	for name, id := range lockA.Params {
+		require.NotEqual(t, id, lockB.Params["q.y"], "q.y reuses ID of %s", name)
+	}
+
+	// Ensure pinned graph nodes and parameters keep their identifiers
+	for _, qr := range modB.QueryEndpoints {
+		require.Equal(t, lockA.GraphNodes[qr.Name], qr.GraphNodeID())
+		if qr.Name == "q" {
+			require.Equal(t, lockA.Params["q.x"], qr.Parameters[1].ID)
+		}
+	}
+
+	// Ensure retired identifiers are kept
+	require.Equal(t, lockA.Types["B"], lockB.Types["B"])
+	require.Equal(t, lockA.GraphNodes["B.b"], lockB.GraphNodes["B.b"])
+}
+
+// TestIDLockErrs tests ID lock conflicts
+func TestIDLockErrs(t *testing.T) {
+	const source = `schema test
+	struct A {
+		a String
+	}
+	query q A`
+
+	setLock := func(lock *parser.IDLock) func(*parser.Parser) {
+		return func(pr *parser.Parser) { pr.SetIDLock(lock) }
+	}
+
+	testErrs(t, map[string]ErrCase{
+		"DuplicateTypeID": ErrCase{
+			Src: source,
+			Errs: []ErrCode{
+				parser.ErrIDLockConflict,
+			},
+			Setup: setLock(&parser.IDLock{
+				Types: map[string]parser.TypeID{"A": 100, "B": 100},
+			}),
+		},
+		"ReservedTypeID": ErrCase{
+			Src: source,
+			Errs: []ErrCode{
+				parser.ErrIDLockConflict,
+			},
+			Setup: setLock(&parser.IDLock{
+				Types: map[string]parser.TypeID{"A": 12},
+			}),
+		},
+		"DuplicateGraphNodeID": ErrCase{
+			Src: source,
+			Errs: []ErrCode{
+				parser.ErrIDLockConflict,
+			},
+			Setup: setLock(&parser.IDLock{
+				GraphNodes: map[string]parser.GraphNodeID{"A.a": 1, "q": 1},
+			}),
+		},
+		"IllegalParamID": ErrCase{
+			Src: source,
+			Errs: []ErrCode{
+				parser.ErrIDLockConflict,
+			},
+			Setup: setLock(&parser.IDLock{
+				Params: map[string]parser.ParamID{"q.x": 0},
+			}),
+		},
+	})
+}
+
+// TestModelJSONRoundTrip tests rebuilding the schema model from JSON
+func TestModelJSONRoundTrip(t *testing.T) {
+	src := `schema test
+	enum Color { red green blue }
+	alias Colors = []Color
+	scalar Cents Int64 where min(0)
+	union Result { Item ErrNotFound }
+	struct ErrNotFound { reason String }
+
+	# Named is implemented by anything named
+	trait Named {
+		name String
+	}
+
+	struct Item implements Named {
+		name String
+		color ?Color
+		prices [String]Cents
+		tags []?String
+		aliases ?[]String
+		flags [String]?Bool
+		matrix [][]?Bool
+	}
+
+	struct Filter {
+		colors Colors
+		limit Uint32 where max(100)
+	}
+
+	resolver Shop implements Named {
+		name String
+		items(
+			filter Filter = {colors: [red, blue], limit: 10}
+		) []Item
+		item(name String) Result
+	}
+
+	query shop(color Color = green, note ?String = null) Shop
+	deprecated("use shop")
+	query items []Item
+	mutation addItem(item Item) Result
+	subscription itemAdded(colors ?Colors) Item`
+
+	test(t, src, func(mod SchemaModel) {
+		encoded, err := mod.MarshalJSON()
+		require.NoError(t, err)
+
+		decoded := &parser.SchemaModel{}
+		require.NoError(t, json.Unmarshal(encoded, decoded))
+		verifyModel(t, decoded)
+
+		// Ensure the rebuilt model encodes to the same JSON model
+		reencoded, err := decoded.MarshalJSON()
+		require.NoError(t, err)
+		require.JSONEq(t, string(encoded), string(reencoded))
+
+		require.Equal(t, "test", decoded.SchemaName)
+		require.Len(t, decoded.Types, len(mod.Types))
+		for i, tp := range mod.Types {
+			require.Equal(t, tp.String(), decoded.Types[i].String())
+			require.Equal(t, tp.TypeID(), decoded.Types[i].TypeID())
+		}
+		require.Len(t, decoded.GraphNodes, len(mod.GraphNodes))
+		for _, node := range mod.GraphNodes {
+			rebuilt := decoded.FindGraphNodeByID(node.GraphNodeID())
+			require.NotNil(t, rebuilt)
+			require.Equal(t, node.GraphNodeName(), rebuilt.GraphNodeName())
+		}
+
+		// Ensure the object graph is linked
+		tItem := decoded.FindTypeByDesignation("Item").(*parser.TypeStruct)
+		tNamed := decoded.FindTypeByDesignation("Named").(*parser.TypeTrait)
+		tShop := decoded.FindTypeByDesignation("Shop").(*parser.TypeResolver)
+		tColor := decoded.FindTypeByDesignation("Color").(*parser.TypeEnum)
+		require.Equal(t, tItem, tItem.Fields[0].Struct)
+		require.Equal(t, []*parser.TypeTrait{tNamed}, tItem.Implements)
+		require.Equal(t, tNamed, tNamed.Properties[0].Trait)
+		require.Equal(t, "Named is implemented by anything named", tNamed.Doc)
+		require.Contains(t, tNamed.Implementations, parser.Type(tItem))
+		require.Contains(t, tNamed.Implementations, parser.Type(tShop))
+		require.Equal(t, tShop, tShop.Properties[1].Resolver)
+		require.Equal(t, tColor, tColor.Values[0].Enum)
+
+		tOptColor := tItem.Fields[1].Type.(*parser.TypeOptional)
+		require.Equal(t, tColor, tOptColor.StoreType)
+		require.Equal(t, tColor, tOptColor.Terminal)
+		require.Equal(t, decoded.FindTypeByDesignation("?Color"), tOptColor)
+
+		tPrices := tItem.Fields[2].Type.(*parser.TypeMap)
+		require.Equal(t, parser.TypeStdString{}, tPrices.KeyType)
+		require.Equal(t, decoded.FindTypeByDesignation("Cents"), tPrices.StoreType)
+
+		// Ensure nested anonymous types are linked
+		for i, designation := range []string{
+			"[]?String",
+			"?[]String",
+			"[String]?Bool",
+			"[][]?Bool",
+		} {
+			tp := tItem.Fields[3+i].Type
+			require.Equal(t, designation, tp.String())
+			require.Equal(t, decoded.FindTypeByDesignation(designation), tp)
+		}
+		tMatrix := tItem.Fields[6].Type.(*parser.TypeList)
+		require.Equal(
+			t,
+			decoded.FindTypeByDesignation("[]?Bool"),
+			tMatrix.StoreType,
+		)
+		require.Equal(
+			t,
+			decoded.FindTypeByDesignation("?Bool"),
+			tMatrix.StoreType.(*parser.TypeList).StoreType,
+		)
+		require.Equal(t, parser.TypeStdBool{}, tMatrix.Terminal)
+
+		tCents := decoded.FindTypeByDesignation("Cents").(*parser.TypeScalar)
+		require.Equal(t, parser.TypeStdInt64{}, tCents.BaseType)
+		require.Equal(t, parser.ConstraintMin, tCents.Constraints[0].Kind)
+
+		// Ensure parameters are linked and enum values restored
+		itemsProp := tShop.Properties[1]
+		filter := itemsProp.Parameters[0]
+		require.Equal(t, itemsProp, filter.Target)
+		require.Equal(t, filter, decoded.FindParameterByID(filter.ID))
+		colors := filter.Default.Field("colors").Value
+		require.Equal(t, parser.ValueKindList, colors.Kind)
+		require.Equal(t, parser.ValueKindEnum, colors.Items[0].Kind)
+		require.Equal(t, "red", colors.Items[0].Literal)
+		limit := filter.Default.Field("limit").Value
+		require.Equal(t, parser.ValueKindNumber, limit.Kind)
+		require.Equal(t, "10", limit.Literal)
+
+		shop := decoded.QueryEndpoints[1]
+		require.Equal(t, "shop", shop.Name)
+		require.Equal(t, tShop, shop.Type)
+		require.Equal(t, parser.ValueKindEnum, shop.Parameters[0].Default.Kind)
+		require.Equal(t, shop, shop.Parameters[0].Target)
+		require.NotNil(t, shop.Parameters[1].Default)
+		require.Equal(t, parser.ValueKindNull, shop.Parameters[1].Default.Kind)
+		require.Nil(t, decoded.Mutations[0].Parameters[0].Default)
+		require.Equal(t, "use shop", decoded.QueryEndpoints[0].Deprecated.Reason)
+		require.Equal(t, "addItem", decoded.Mutations[0].Name)
+		require.Equal(t, "itemAdded", decoded.Subscriptions[0].Name)
+	})
+}
+
+// TestModelJSONIntegrityErrs tests rebuilding the schema model
+// from referentially broken JSON models
+func TestModelJSONIntegrityErrs(t *testing.T) {
+	for name, src := range map[string]string{
+		"UndefinedType": `{
+			"query-endpoints": [
+				{"name": "q", "type": 100, "graph-node-id": 1}
+			]
+		}`,
+		"DuplicateTypeID": `{
+			"struct-types": [
+				{"name": "A", "id": 100, "fields": []},
+				{"name": "B", "id": 100, "fields": []}
+			]
+		}`,
+		"ReservedTypeID": `{
+			"struct-types": [{"name": "A", "id": 5, "fields": []}]
+		}`,
+		"DuplicateGraphNodeID": `{
+			"query-endpoints": [
+				{"name": "a", "type": 9, "graph-node-id": 1},
+				{"name": "b", "type": 9, "graph-node-id": 1}
+			]
+		}`,
+		"DuplicateParamID": `{
+			"query-endpoints": [{
+				"name": "a",
+				"type": 9,
+				"graph-node-id": 1,
+				"parameters": [
+					{"name": "x", "type": 9, "graph-param-id": 1},
+					{"name": "y", "type": 9, "graph-param-id": 1}
+				]
+			}]
+		}`,
+		"ImplementsNonTrait": `{
+			"struct-types": [
+				{"name": "A", "id": 100, "fields": [], "implements": [101]},
+				{"name": "B", "id": 101, "fields": []}
+			]
+		}`,
+		"AnonymousDesignationMismatch": `{
+			"anonymous-types": [
+				{"designation": "[]Int32", "id": 100, "store-type": 9}
+			]
+		}`,
+		"AnonymousRecursion": `{
+			"anonymous-types": [
+				{"designation": "[]?", "id": 100, "store-type": 101},
+				{"designation": "?[]", "id": 101, "store-type": 100}
+			]
+		}`,
+		"AliasRecursion": `{
+			"alias-types": [
+				{"name": "A", "id": 100, "aliased-type-id": 100}
+			]
+		}`,
+		"AliasCycle": `{
+			"alias-types": [
+				{"name": "A", "id": 100, "aliased-type-id": 101},
+				{"name": "B", "id": 101, "aliased-type-id": 100}
+			]
+		}`,
+		"UnknownConstraint": `{
+			"scalar-types": [{
+				"name": "S",
+				"id": 100,
+				"base-type-id": 9,
+				"constraints": [{"kind": "unknown"}]
+			}]
+		}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			mod := &parser.SchemaModel{}
+			require.Error(t, json.Unmarshal([]byte(src), mod))
+		})
+	}
 }
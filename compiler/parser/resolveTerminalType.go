@@ -0,0 +1,111 @@
+package parser
+
+import "fmt"
+
+// resolveTerminalType resolves the terminal type referenced by the given
+// type identifier within the given type parameter environment instantiating
+// generic types with the given type arguments. fArgs is nil if the reference
+// has no type argument list. Returns nil if the type couldn't be resolved
+// or if the reference is part of a generic type declaration template.
+// Errors of references in instances are only reported for the template
+func (pr *Parser) resolveTerminalType(
+	tk *Token,
+	env *typeEnv,
+	fArgs Fragment,
+	args []Type,
+) Type {
+	report := func(err *pErr) {
+		if !env.isInstance() {
+			pr.err(err)
+		}
+	}
+
+	// Type parameters
+	if arg, isParam := env.arg(tk.src); isParam {
+		if fArgs != nil {
+			report(&pErr{
+				at:   fArgs.Begin(),
+				code: ErrTypeNotGeneric,
+				message: fmt.Sprintf(
+					"type parameter %s doesn't accept type arguments",
+					tk.src,
+				),
+			})
+			return nil
+		}
+		return arg
+	}
+
+	generic := pr.genericByName[tk.src]
+	t := pr.findTypeByDesignation(tk.src)
+	if t == nil && generic == nil {
+		if pr.isFailedTypeDecl(tk.src) {
+			// The declaration of the type failed and was already reported
+			return nil
+		}
+		report(&pErr{
+			at:   tk.begin,
+			code: ErrTypeUndef,
+			message: fmt.Sprintf(
+				"terminal type %s is undefined",
+				tk.src,
+			),
+		})
+		return nil
+	}
+
+	// Non-generic types
+	if generic == nil {
+		if fArgs != nil {
+			report(&pErr{
+				at:   fArgs.Begin(),
+				code: ErrTypeNotGeneric,
+				message: fmt.Sprintf(
+					"type %s isn't generic and doesn't accept type arguments",
+					tk.src,
+				),
+			})
+			return nil
+		}
+		if env.isTemplate() {
+			return nil
+		}
+		return t
+	}
+
+	// Generic types
+	if fArgs == nil {
+		report(&pErr{
+			at:   tk.begin,
+			code: ErrGenericMissingArgs,
+			message: fmt.Sprintf(
+				"generic type %s is missing type arguments",
+				tk.src,
+			),
+		})
+		return nil
+	}
+	if len(args) != len(generic.params) {
+		report(&pErr{
+			at:   fArgs.Begin(),
+			code: ErrGenericArgCount,
+			message: fmt.Sprintf(
+				"generic type %s expects %d type arguments, got %d",
+				tk.src,
+				len(generic.params),
+				len(args),
+			),
+		})
+		return nil
+	}
+	if env.isTemplate() {
+		return nil
+	}
+	for _, arg := range args {
+		if arg == nil {
+			// Unresolved type arguments are reported elsewhere
+			return nil
+		}
+	}
+	return pr.instantiateGeneric(tk, generic, args, env.instDepth())
+}
@@ -0,0 +1,114 @@
+package parser
+
+// isDeclKeyword returns true if the given word is a keyword
+// beginning a top-level declaration, otherwise returns false
+func isDeclKeyword(word string) bool {
+	switch word {
+	case KeywordImport,
+		KeywordAlias,
+		KeywordScalar,
+		KeywordEnum,
+		KeywordUnion,
+		KeywordStruct,
+		KeywordResolver,
+		KeywordTrait,
+		KeywordQuery,
+		KeywordMutation,
+		KeywordSubscription:
+		return true
+	}
+	return false
+}
+
+// isTypeDeclKeyword returns true if the given word is a keyword
+// beginning a type declaration, otherwise returns false
+func isTypeDeclKeyword(word string) bool {
+	switch word {
+	case KeywordAlias,
+		KeywordScalar,
+		KeywordEnum,
+		KeywordUnion,
+		KeywordStruct,
+		KeywordResolver,
+		KeywordTrait:
+		return true
+	}
+	return false
+}
+
+// resync recovers from a failed top-level declaration by skipping
+// everything up until either the next top-level declaration keyword or
+// the closing '}' of the block opened by the failed declaration.
+// A '}' closing no block isn't skipped to have it reported.
+// Scanning begins right after the given token, or at the current lexer
+// position if there's none. Keywords inside of blocks are ignored
+// since they could be field or property names. Blocks inside of
+// parentheses (such as struct literals of parameter default values)
+// don't end the failed declaration
+func (pr *Parser) resync(lex *Lexer, after *Token) {
+	if after != nil {
+		lex.tail = after.end
+		lex.docLine = false
+
+		// Remember the name of the type that failed to be declared
+		// to avoid reporting it as undefined wherever it's referenced
+		if after.id == FragTkLatinAlphanum && isTypeDeclKeyword(after.src) {
+			name, err := lex.New().NextSkip(Skip{FragTkSpace, FragTkComment})
+			if err == nil && name != nil && name.id == FragTkLatinAlphanum {
+				pr.failedTypeDecls[name.src] = struct{}{}
+			}
+		}
+	}
+
+	depth := 0
+	parDepth := 0
+	for {
+		peeker := lex.New()
+		tk, err := peeker.Next()
+		if err != nil {
+			// Skip illegal characters
+			lex.skipChar()
+			continue
+		}
+		if tk == nil {
+			// EOF
+			return
+		}
+
+		switch tk.id {
+		case FragTkBlk:
+			depth++
+		case FragTkPar:
+			parDepth++
+		case FragTkParEnd:
+			if parDepth > 0 {
+				parDepth--
+			}
+		case FragTkBlkEnd:
+			if depth < 1 && parDepth < 1 {
+				// Stray closing '}' to be reported by the caller
+				return
+			}
+			if depth < 2 && parDepth < 1 {
+				// End of the failed declaration's block reached
+				lex.tail = peeker.tail
+				return
+			}
+			depth--
+		case FragTkLatinAlphanum:
+			if depth < 1 && isDeclKeyword(tk.src) {
+				// Next top-level declaration reached
+				return
+			}
+		}
+		lex.tail = peeker.tail
+		lex.docLine = peeker.docLine
+	}
+}
+
+// isFailedTypeDecl returns true if the declaration of the type
+// with the given name failed, otherwise returns false
+func (pr *Parser) isFailedTypeDecl(name string) bool {
+	_, failed := pr.failedTypeDecls[name]
+	return failed
+}
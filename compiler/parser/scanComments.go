@@ -0,0 +1,58 @@
+package parser
+
+import "sort"
+
+// scanComments returns the given fragments with all comment tokens
+// of the source file inserted into the innermost constructs containing
+// them in order of appearance. Comments are scanned separately
+// since they're skipped during parsing
+func scanComments(lex *Lexer, frags []Fragment) []Fragment {
+	scanner := &Lexer{
+		src: lex.src,
+		tail: Cursor{
+			Index:  0,
+			Line:   1,
+			Column: 1,
+			File:   &lex.src.File,
+		},
+	}
+
+	for {
+		tk, err := scanner.Next()
+		if err != nil || tk == nil {
+			// Lexical errors are reported by the parser
+			break
+		}
+		if tk.id != FragTkComment {
+			continue
+		}
+		frags = insertComment(frags, tk)
+	}
+	return frags
+}
+
+// insertComment inserts the given comment token into the innermost
+// construct of the given fragments containing it or between the fragments
+// if none contains it. Returns the resulting fragments
+func insertComment(frags []Fragment, comment *Token) []Fragment {
+	// Find the first fragment beginning after the comment
+	at := sort.Search(len(frags), func(i int) bool {
+		return frags[i].Begin().Index > comment.begin.Index
+	})
+
+	// Descend into the preceding construct if it contains the comment
+	if at > 0 {
+		con, isConstruct := frags[at-1].(*Construct)
+		if isConstruct && con.end.Index > comment.begin.Index {
+			con.elements = insertComment(con.elements, comment)
+			return frags
+		}
+	}
+
+	// Copy the fragments since the backing array
+	// may be shared with other constructs
+	inserted := make([]Fragment, 0, len(frags)+1)
+	inserted = append(inserted, frags[:at]...)
+	inserted = append(inserted, comment)
+	return append(inserted, frags[at:]...)
+}
@@ -20,13 +20,16 @@ type SchemaModel struct {
 	SchemaName     string
 	Types          []Type
 	AliasTypes     []Type
+	ScalarTypes    []Type
 	EnumTypes      []Type
 	UnionTypes     []Type
 	StructTypes    []Type
 	ResolverTypes  []Type
+	TraitTypes     []Type
 	AnonymousTypes []Type
 	QueryEndpoints []*Query
 	Mutations      []*Mutation
+	Subscriptions  []*Subscription
 	GraphNodes     []GraphNode
 }
 
@@ -42,6 +45,9 @@ func (mod *SchemaModel) Clone() *SchemaModel {
 	aliasTypes := make([]Type, len(mod.AliasTypes))
 	copy(aliasTypes, mod.AliasTypes)
 
+	scalarTypes := make([]Type, len(mod.ScalarTypes))
+	copy(scalarTypes, mod.ScalarTypes)
+
 	enumTypes := make([]Type, len(mod.EnumTypes))
 	copy(enumTypes, mod.EnumTypes)
 
@@ -54,6 +60,9 @@ func (mod *SchemaModel) Clone() *SchemaModel {
 	resolverTypes := make([]Type, len(mod.ResolverTypes))
 	copy(resolverTypes, mod.ResolverTypes)
 
+	traitTypes := make([]Type, len(mod.TraitTypes))
+	copy(traitTypes, mod.TraitTypes)
+
 	anonymousTypes := make([]Type, len(mod.AnonymousTypes))
 	copy(anonymousTypes, mod.AnonymousTypes)
 
@@ -63,6 +72,9 @@ func (mod *SchemaModel) Clone() *SchemaModel {
 	mutations := make([]*Mutation, len(mod.Mutations))
 	copy(mutations, mod.Mutations)
 
+	subscriptions := make([]*Subscription, len(mod.Subscriptions))
+	copy(subscriptions, mod.Subscriptions)
+
 	graphNodes := make([]GraphNode, len(mod.GraphNodes))
 	copy(graphNodes, mod.GraphNodes)
 
@@ -70,13 +82,16 @@ func (mod *SchemaModel) Clone() *SchemaModel {
 		SchemaName:     mod.SchemaName,
 		Types:          types,
 		AliasTypes:     aliasTypes,
+		ScalarTypes:    scalarTypes,
 		EnumTypes:      enumTypes,
 		UnionTypes:     unionTypes,
 		StructTypes:    structTypes,
 		ResolverTypes:  resolverTypes,
+		TraitTypes:     traitTypes,
 		AnonymousTypes: anonymousTypes,
 		QueryEndpoints: queryEndpoints,
 		Mutations:      mutations,
+		Subscriptions:  subscriptions,
 		GraphNodes:     graphNodes,
 	}
 }
@@ -127,6 +142,15 @@ func (mod *SchemaModel) FindParameterByID(id ParamID) *Parameter {
 			}
 		}
 	}
+	for _, trt := range mod.TraitTypes {
+		for _, prop := range trt.(*TypeTrait).Properties {
+			for _, param := range prop.Parameters {
+				if param.ID == id {
+					return param
+				}
+			}
+		}
+	}
 	for _, qry := range mod.QueryEndpoints {
 		for _, param := range qry.Parameters {
 			if param.ID == id {
@@ -141,6 +165,12 @@ func (mod *SchemaModel) FindParameterByID(id ParamID) *Parameter {
 			}
 		}
 	}
-	//TODO: search in subscriptions
+	for _, sub := range mod.Subscriptions {
+		for _, param := range sub.Parameters {
+			if param.ID == id {
+				return param
+			}
+		}
+	}
 	return nil
 }
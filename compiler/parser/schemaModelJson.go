@@ -8,96 +8,228 @@ import (
 type JSONSchemaModel struct {
 	SchemaName     string                   `json:"schema-name"`
 	AliasTypes     []JSONModelAliasType     `json:"alias-types"`
+	ScalarTypes    []JSONModelScalarType    `json:"scalar-types"`
 	EnumTypes      []JSONModelEnumType      `json:"enum-types"`
 	UnionTypes     []JSONModelUnionType     `json:"union-types"`
 	StructTypes    []JSONModelStructType    `json:"struct-types"`
 	ResolverTypes  []JSONModelResolverType  `json:"resolver-types"`
+	TraitTypes     []JSONModelTraitType     `json:"trait-types"`
 	AnonymousTypes []JSONModelAnonymousType `json:"anonymous-types"`
 	QueryEndpoints []JSONModelQueryEndpoint `json:"query-endpoints"`
 	Mutations      []JSONModelMutation      `json:"mutations"`
+	Subscriptions  []JSONModelSubscription  `json:"subscriptions"`
+}
+
+// JSONModelDeprecation represents the JSON model of a deprecation marker
+type JSONModelDeprecation struct {
+	Reason      string `json:"reason"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// JSONModelAnnotation represents the JSON model of an annotation
+type JSONModelAnnotation struct {
+	Name string   `json:"name"`
+	Args []*Value `json:"args,omitempty"`
+}
+
+// JSONModelConstraint represents the JSON model of a value constraint
+type JSONModelConstraint struct {
+	Kind  string `json:"kind"`
+	Value *Value `json:"value,omitempty"`
 }
 
 // JSONModelAliasType represents the JSON model of an alias type
 type JSONModelAliasType struct {
-	Name          string `json:"name"`
-	ID            int    `json:"id"`
-	AliasedTypeID int    `json:"aliased-type-id"`
+	Name          string                `json:"name"`
+	ID            int                   `json:"id"`
+	AliasedTypeID int                   `json:"aliased-type-id"`
+	Doc           string                `json:"doc,omitempty"`
+	Deprecated    *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations   []JSONModelAnnotation `json:"annotations,omitempty"`
+}
+
+// JSONModelScalarType represents the JSON model of a scalar type
+type JSONModelScalarType struct {
+	Name        string                `json:"name"`
+	ID          int                   `json:"id"`
+	BaseTypeID  int                   `json:"base-type-id"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
+	Constraints []JSONModelConstraint `json:"constraints,omitempty"`
 }
 
 // JSONModelEnumType represents the JSON model of an enum type
 type JSONModelEnumType struct {
-	Name   string   `json:"name"`
-	ID     int      `json:"id"`
-	Values []string `json:"values"`
+	Name             string                           `json:"name"`
+	ID               int                              `json:"id"`
+	Values           []string                         `json:"values"`
+	Doc              string                           `json:"doc,omitempty"`
+	Deprecated       *JSONModelDeprecation            `json:"deprecated,omitempty"`
+	Annotations      []JSONModelAnnotation            `json:"annotations,omitempty"`
+	ValueDocs        map[string]string                `json:"value-docs,omitempty"`
+	ValueAnnotations map[string][]JSONModelAnnotation `json:"value-annotations,omitempty"`
 }
 
 // JSONModelUnionType represents the JSON model of a union type
 type JSONModelUnionType struct {
-	Name        string `json:"name"`
-	ID          int    `json:"id"`
-	OptionTypes []int  `json:"option-types"`
+	Name        string                `json:"name"`
+	ID          int                   `json:"id"`
+	OptionTypes []int                 `json:"option-types"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
 }
 
 // JSONModelStructField represents the JSON model of a struct field
 type JSONModelStructField struct {
-	Name        string `json:"name"`
-	Type        int    `json:"type"`
-	GraphNodeID int    `json:"graph-node-id"`
+	Name        string                `json:"name"`
+	Type        int                   `json:"type"`
+	GraphNodeID int                   `json:"graph-node-id"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
+	Constraints []JSONModelConstraint `json:"constraints,omitempty"`
 }
 
 // JSONModelStructType represents the JSON model of a struct type
 type JSONModelStructType struct {
-	Name   string                 `json:"name"`
-	ID     int                    `json:"id"`
-	Fields []JSONModelStructField `json:"fields"`
+	Name        string                 `json:"name"`
+	ID          int                    `json:"id"`
+	Fields      []JSONModelStructField `json:"fields"`
+	Implements  []int                  `json:"implements"`
+	Doc         string                 `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation  `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation  `json:"annotations,omitempty"`
 }
 
 // JSONModelParameter represents the JSON model of a parameter
 type JSONModelParameter struct {
-	Name         string `json:"name"`
-	Type         int    `json:"type"`
-	GraphParamID int    `json:"graph-param-id"`
+	Name         string                `json:"name"`
+	Type         int                   `json:"type"`
+	GraphParamID int                   `json:"graph-param-id"`
+	Doc          string                `json:"doc,omitempty"`
+	Default      *Value                `json:"default,omitempty"`
+	Annotations  []JSONModelAnnotation `json:"annotations,omitempty"`
+	Constraints  []JSONModelConstraint `json:"constraints,omitempty"`
 }
 
 // JSONModelResolverProperty represents the JSON model of a resolver property
 type JSONModelResolverProperty struct {
-	Name        string               `json:"name"`
-	Type        int                  `json:"type"`
-	GraphNodeID int                  `json:"graph-node-id"`
-	Parameters  []JSONModelParameter `json:"parameters"`
+	Name        string                `json:"name"`
+	Type        int                   `json:"type"`
+	GraphNodeID int                   `json:"graph-node-id"`
+	Parameters  []JSONModelParameter  `json:"parameters"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
 }
 
 // JSONModelResolverType represents the JSON model of a resolver type
 type JSONModelResolverType struct {
-	Name       string                      `json:"name"`
-	ID         int                         `json:"id"`
-	Properties []JSONModelResolverProperty `json:"properties"`
+	Name        string                      `json:"name"`
+	ID          int                         `json:"id"`
+	Properties  []JSONModelResolverProperty `json:"properties"`
+	Implements  []int                       `json:"implements"`
+	Doc         string                      `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation       `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation       `json:"annotations,omitempty"`
+}
+
+// JSONModelTraitProperty represents the JSON model of a trait property
+type JSONModelTraitProperty struct {
+	Name        string                `json:"name"`
+	Type        int                   `json:"type"`
+	GraphNodeID int                   `json:"graph-node-id"`
+	Parameters  []JSONModelParameter  `json:"parameters"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
+}
+
+// JSONModelTraitType represents the JSON model of a trait type
+type JSONModelTraitType struct {
+	Name            string                   `json:"name"`
+	ID              int                      `json:"id"`
+	Pure            bool                     `json:"pure"`
+	Properties      []JSONModelTraitProperty `json:"properties"`
+	Implementations []int                    `json:"implementations"`
+	Doc             string                   `json:"doc,omitempty"`
+	Deprecated      *JSONModelDeprecation    `json:"deprecated,omitempty"`
+	Annotations     []JSONModelAnnotation    `json:"annotations,omitempty"`
 }
 
 // JSONModelAnonymousType represents the JSON model of an anonymous type
 type JSONModelAnonymousType struct {
 	Designation string `json:"designation"`
 	ID          int    `json:"id"`
+	StoreType   int    `json:"store-type"`
+	KeyType     int    `json:"key-type,omitempty"`
 }
 
 // JSONModelQueryEndpoint represents the JSON model of a query endpoint
 type JSONModelQueryEndpoint struct {
-	Name        string               `json:"name"`
-	Type        int                  `json:"type"`
-	GraphNodeID int                  `json:"graph-node-id"`
-	Parameters  []JSONModelParameter `json:"parameters"`
+	Name        string                `json:"name"`
+	Type        int                   `json:"type"`
+	GraphNodeID int                   `json:"graph-node-id"`
+	Parameters  []JSONModelParameter  `json:"parameters"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
 }
 
 // JSONModelMutation represents the JSON model of a mutation
 type JSONModelMutation struct {
-	Name        string               `json:"name"`
-	Type        int                  `json:"type"`
-	GraphNodeID int                  `json:"graph-node-id"`
-	Parameters  []JSONModelParameter `json:"parameters"`
+	Name        string                `json:"name"`
+	Type        int                   `json:"type"`
+	GraphNodeID int                   `json:"graph-node-id"`
+	Parameters  []JSONModelParameter  `json:"parameters"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
+}
+
+// JSONModelSubscription represents the JSON model of a subscription
+type JSONModelSubscription struct {
+	Name        string                `json:"name"`
+	Type        int                   `json:"type"`
+	GraphNodeID int                   `json:"graph-node-id"`
+	Parameters  []JSONModelParameter  `json:"parameters"`
+	Doc         string                `json:"doc,omitempty"`
+	Deprecated  *JSONModelDeprecation `json:"deprecated,omitempty"`
+	Annotations []JSONModelAnnotation `json:"annotations,omitempty"`
 }
 
 // MarshalJSON marshal the schema model into its JSON representation
 func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
+	copyAnnotations := func(as []*Annotation) []JSONModelAnnotation {
+		if len(as) < 1 {
+			return nil
+		}
+		v := make([]JSONModelAnnotation, len(as))
+		for i, a := range as {
+			v[i] = JSONModelAnnotation{
+				Name: a.Name,
+				Args: a.Args,
+			}
+		}
+		return v
+	}
+
+	copyConstraints := func(cs []*Constraint) []JSONModelConstraint {
+		if len(cs) < 1 {
+			return nil
+		}
+		v := make([]JSONModelConstraint, len(cs))
+		for i, c := range cs {
+			v[i] = JSONModelConstraint{
+				Kind:  c.Kind.String(),
+				Value: c.Value,
+			}
+		}
+		return v
+	}
+
 	copyParams := func(ps []*Parameter) []JSONModelParameter {
 		v := make([]JSONModelParameter, len(ps))
 		for i, p := range ps {
@@ -105,21 +237,54 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 				Name:         p.Name,
 				Type:         int(p.Type.TypeID()),
 				GraphParamID: int(p.ID),
+				Doc:          p.Doc,
+				Default:      p.Default,
+				Annotations:  copyAnnotations(p.Annotations),
+				Constraints:  copyConstraints(p.Constraints),
 			}
 		}
 		return v
 	}
 
+	copyDeprecation := func(d *Deprecation) *JSONModelDeprecation {
+		if d == nil {
+			return nil
+		}
+		return &JSONModelDeprecation{
+			Reason:      d.Reason,
+			Replacement: d.Replacement,
+		}
+	}
+
+	copyTypeIDs := func(ts []Type) []int {
+		v := make([]int, len(ts))
+		for i, t := range ts {
+			v[i] = int(t.TypeID())
+		}
+		return v
+	}
+
+	copyTraitIDs := func(ts []*TypeTrait) []int {
+		v := make([]int, len(ts))
+		for i, t := range ts {
+			v[i] = int(t.TypeID())
+		}
+		return v
+	}
+
 	model := &JSONSchemaModel{
 		SchemaName:     mod.SchemaName,
 		AliasTypes:     make([]JSONModelAliasType, len(mod.AliasTypes)),
+		ScalarTypes:    make([]JSONModelScalarType, len(mod.ScalarTypes)),
 		EnumTypes:      make([]JSONModelEnumType, len(mod.EnumTypes)),
 		UnionTypes:     make([]JSONModelUnionType, len(mod.UnionTypes)),
 		StructTypes:    make([]JSONModelStructType, len(mod.StructTypes)),
 		ResolverTypes:  make([]JSONModelResolverType, len(mod.ResolverTypes)),
+		TraitTypes:     make([]JSONModelTraitType, len(mod.TraitTypes)),
 		AnonymousTypes: make([]JSONModelAnonymousType, len(mod.AnonymousTypes)),
 		QueryEndpoints: make([]JSONModelQueryEndpoint, len(mod.QueryEndpoints)),
 		Mutations:      make([]JSONModelMutation, len(mod.Mutations)),
+		Subscriptions:  make([]JSONModelSubscription, len(mod.Subscriptions)),
 	}
 
 	// Alias types
@@ -130,6 +295,24 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 			Name:          v.Name,
 			ID:            int(v.ID),
 			AliasedTypeID: int(v.AliasedType.TypeID()),
+			Doc:           v.Doc,
+			Deprecated:    copyDeprecation(v.Deprecated),
+			Annotations:   copyAnnotations(v.Annotations),
+		}
+	}
+
+	// Scalar types
+	for i, t := range mod.ScalarTypes {
+		v := t.(*TypeScalar)
+
+		model.ScalarTypes[i] = JSONModelScalarType{
+			Name:        v.Name,
+			ID:          int(v.ID),
+			BaseTypeID:  int(v.BaseType.TypeID()),
+			Doc:         v.Doc,
+			Deprecated:  copyDeprecation(v.Deprecated),
+			Annotations: copyAnnotations(v.Annotations),
+			Constraints: copyConstraints(v.Constraints),
 		}
 	}
 
@@ -139,14 +322,34 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 
 		// Values
 		vals := make([]string, len(v.Values))
+		var valDocs map[string]string
+		var valAnnotations map[string][]JSONModelAnnotation
 		for i, val := range v.Values {
 			vals[i] = val.Name
+			if val.Doc != "" {
+				if valDocs == nil {
+					valDocs = make(map[string]string)
+				}
+				valDocs[val.Name] = val.Doc
+			}
+			if len(val.Annotations) > 0 {
+				if valAnnotations == nil {
+					valAnnotations = make(map[string][]JSONModelAnnotation)
+				}
+				valAnnotations[val.Name] = copyAnnotations(val.Annotations)
+			}
 		}
 
 		model.EnumTypes[i] = JSONModelEnumType{
-			Name:   v.Name,
-			ID:     int(v.ID),
-			Values: vals,
+			Name:        v.Name,
+			ID:          int(v.ID),
+			Values:      vals,
+			Doc:         v.Doc,
+			Deprecated:  copyDeprecation(v.Deprecated),
+			Annotations: copyAnnotations(v.Annotations),
+			ValueDocs:   valDocs,
+
+			ValueAnnotations: valAnnotations,
 		}
 	}
 
@@ -164,6 +367,9 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 			Name:        v.Name,
 			ID:          int(v.ID),
 			OptionTypes: opts,
+			Doc:         v.Doc,
+			Deprecated:  copyDeprecation(v.Deprecated),
+			Annotations: copyAnnotations(v.Annotations),
 		}
 	}
 
@@ -178,13 +384,21 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 				Name:        fld.Name,
 				Type:        int(fld.Type.TypeID()),
 				GraphNodeID: int(fld.GraphID),
+				Doc:         fld.Doc,
+				Deprecated:  copyDeprecation(fld.Deprecated),
+				Annotations: copyAnnotations(fld.Annotations),
+				Constraints: copyConstraints(fld.Constraints),
 			}
 		}
 
 		model.StructTypes[i] = JSONModelStructType{
-			Name:   v.Name,
-			ID:     int(v.ID),
-			Fields: fields,
+			Name:        v.Name,
+			ID:          int(v.ID),
+			Fields:      fields,
+			Implements:  copyTraitIDs(v.Implements),
+			Doc:         v.Doc,
+			Deprecated:  copyDeprecation(v.Deprecated),
+			Annotations: copyAnnotations(v.Annotations),
 		}
 	}
 
@@ -200,13 +414,50 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 				Type:        int(fld.Type.TypeID()),
 				GraphNodeID: int(fld.GraphID),
 				Parameters:  copyParams(fld.Parameters),
+				Doc:         fld.Doc,
+				Deprecated:  copyDeprecation(fld.Deprecated),
+				Annotations: copyAnnotations(fld.Annotations),
 			}
 		}
 
 		model.ResolverTypes[i] = JSONModelResolverType{
-			Name:       v.Name,
-			ID:         int(v.ID),
-			Properties: props,
+			Name:        v.Name,
+			ID:          int(v.ID),
+			Properties:  props,
+			Implements:  copyTraitIDs(v.Implements),
+			Doc:         v.Doc,
+			Deprecated:  copyDeprecation(v.Deprecated),
+			Annotations: copyAnnotations(v.Annotations),
+		}
+	}
+
+	// Trait types
+	for i, t := range mod.TraitTypes {
+		v := t.(*TypeTrait)
+
+		// Properties
+		props := make([]JSONModelTraitProperty, len(v.Properties))
+		for i, prop := range v.Properties {
+			props[i] = JSONModelTraitProperty{
+				Name:        prop.Name,
+				Type:        int(prop.Type.TypeID()),
+				GraphNodeID: int(prop.GraphID),
+				Parameters:  copyParams(prop.Parameters),
+				Doc:         prop.Doc,
+				Deprecated:  copyDeprecation(prop.Deprecated),
+				Annotations: copyAnnotations(prop.Annotations),
+			}
+		}
+
+		model.TraitTypes[i] = JSONModelTraitType{
+			Name:            v.Name,
+			ID:              int(v.ID),
+			Pure:            v.Pure,
+			Properties:      props,
+			Implementations: copyTypeIDs(v.Implementations),
+			Doc:             v.Doc,
+			Deprecated:      copyDeprecation(v.Deprecated),
+			Annotations:     copyAnnotations(v.Annotations),
 		}
 	}
 
@@ -216,6 +467,15 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 			Designation: t.String(),
 			ID:          int(t.TypeID()),
 		}
+		switch v := t.(type) {
+		case *TypeOptional:
+			model.AnonymousTypes[i].StoreType = int(v.StoreType.TypeID())
+		case *TypeList:
+			model.AnonymousTypes[i].StoreType = int(v.StoreType.TypeID())
+		case *TypeMap:
+			model.AnonymousTypes[i].StoreType = int(v.StoreType.TypeID())
+			model.AnonymousTypes[i].KeyType = int(v.KeyType.TypeID())
+		}
 	}
 
 	// Query endpoints
@@ -225,6 +485,9 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 			GraphNodeID: int(q.GraphID),
 			Parameters:  copyParams(q.Parameters),
 			Type:        int(q.Type.TypeID()),
+			Doc:         q.Doc,
+			Deprecated:  copyDeprecation(q.Deprecated),
+			Annotations: copyAnnotations(q.Annotations),
 		}
 	}
 
@@ -235,6 +498,22 @@ func (mod *SchemaModel) MarshalJSON() ([]byte, error) {
 			GraphNodeID: int(m.GraphID),
 			Parameters:  copyParams(m.Parameters),
 			Type:        int(m.Type.TypeID()),
+			Doc:         m.Doc,
+			Deprecated:  copyDeprecation(m.Deprecated),
+			Annotations: copyAnnotations(m.Annotations),
+		}
+	}
+
+	// Subscriptions
+	for i, s := range mod.Subscriptions {
+		model.Subscriptions[i] = JSONModelSubscription{
+			Name:        s.Name,
+			GraphNodeID: int(s.GraphID),
+			Parameters:  copyParams(s.Parameters),
+			Type:        int(s.Type.TypeID()),
+			Doc:         s.Doc,
+			Deprecated:  copyDeprecation(s.Deprecated),
+			Annotations: copyAnnotations(s.Annotations),
 		}
 	}
 
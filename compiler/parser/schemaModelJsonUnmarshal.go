@@ -0,0 +1,773 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnmarshalJSON rebuilds the schema model from its JSON representation
+// linking all types, graph nodes and parameters by their unique identifiers.
+// Returns an error if the JSON model isn't referentially intact.
+// The source fragments of the rebuilt declarations are nil
+func (mod *SchemaModel) UnmarshalJSON(data []byte) error {
+	model := JSONSchemaModel{}
+	if err := json.Unmarshal(data, &model); err != nil {
+		return err
+	}
+	lk := &modelLinker{
+		typeByID:      make(map[TypeID]Type),
+		graphNodeByID: make(map[GraphNodeID]GraphNode),
+		paramByID:     make(map[ParamID]*Parameter),
+		mod: &SchemaModel{
+			SchemaName:     model.SchemaName,
+			Types:          make([]Type, 0),
+			AliasTypes:     make([]Type, 0, len(model.AliasTypes)),
+			ScalarTypes:    make([]Type, 0, len(model.ScalarTypes)),
+			EnumTypes:      make([]Type, 0, len(model.EnumTypes)),
+			UnionTypes:     make([]Type, 0, len(model.UnionTypes)),
+			StructTypes:    make([]Type, 0, len(model.StructTypes)),
+			ResolverTypes:  make([]Type, 0, len(model.ResolverTypes)),
+			TraitTypes:     make([]Type, 0, len(model.TraitTypes)),
+			AnonymousTypes: make([]Type, 0, len(model.AnonymousTypes)),
+			QueryEndpoints: make([]*Query, 0, len(model.QueryEndpoints)),
+			Mutations:      make([]*Mutation, 0, len(model.Mutations)),
+			Subscriptions:  make([]*Subscription, 0, len(model.Subscriptions)),
+		},
+	}
+	if err := lk.link(&model); err != nil {
+		return err
+	}
+	*mod = *lk.mod
+	return nil
+}
+
+// UnmarshalJSON decodes the JSON model of a parameter.
+// An explicit null default is decoded as a null value
+// rather than as the absence of a default
+func (p *JSONModelParameter) UnmarshalJSON(data []byte) error {
+	type plainParameter JSONModelParameter
+	decoded := struct {
+		*plainParameter
+		Default json.RawMessage `json:"default"`
+	}{plainParameter: (*plainParameter)(p)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	p.Default = nil
+	if decoded.Default != nil {
+		p.Default = &Value{}
+		if err := p.Default.UnmarshalJSON(decoded.Default); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modelLinker links the declarations of a JSON schema model
+type modelLinker struct {
+	mod           *SchemaModel
+	typeByID      map[TypeID]Type
+	graphNodeByID map[GraphNodeID]GraphNode
+	paramByID     map[ParamID]*Parameter
+	params        []*Parameter
+}
+
+// link declares all types first and links the references afterwards
+// since types may reference each other regardless of their order
+func (lk *modelLinker) link(model *JSONSchemaModel) error {
+	if err := lk.declareTypes(model); err != nil {
+		return err
+	}
+	if err := lk.linkAnonymousTypes(model.AnonymousTypes); err != nil {
+		return err
+	}
+	if err := lk.linkTypes(model); err != nil {
+		return err
+	}
+	if err := lk.linkEndpoints(model); err != nil {
+		return err
+	}
+
+	// Restore the enum values of the parameter defaults
+	// which JSON encodes as strings
+	for _, param := range lk.params {
+		restoreEnumValues(param.Default, param.Type)
+	}
+
+	// Restore the order of the parser
+	sortTypesByName(lk.mod.Types)
+	sort.Slice(lk.mod.GraphNodes, func(i, j int) bool {
+		return lk.mod.GraphNodes[i].GraphNodeID() <
+			lk.mod.GraphNodes[j].GraphNodeID()
+	})
+	return nil
+}
+
+// declareType registers a type by its unique identifier
+func (lk *modelLinker) declareType(id int, t Type) error {
+	if TypeID(id) <= TypeIDUserTypeOffset {
+		return fmt.Errorf("type %s has an illegal ID (%d)", t, id)
+	}
+	if defined, isDefined := lk.typeByID[TypeID(id)]; isDefined {
+		return fmt.Errorf(
+			"types %s and %s share the same ID (%d)",
+			defined,
+			t,
+			id,
+		)
+	}
+	lk.typeByID[TypeID(id)] = t
+	lk.mod.Types = append(lk.mod.Types, t)
+	return nil
+}
+
+// typeByRef returns the type referenced by the given unique identifier
+func (lk *modelLinker) typeByRef(id int, referrer string) (Type, error) {
+	if t := stdTypeByID(TypeID(id)); t != nil {
+		return t, nil
+	}
+	if t, isDefined := lk.typeByID[TypeID(id)]; isDefined {
+		return t, nil
+	}
+	return nil, fmt.Errorf("%s references undefined type %d", referrer, id)
+}
+
+// traitsByRef returns the trait types referenced by the given identifiers
+func (lk *modelLinker) traitsByRef(
+	ids []int,
+	referrer string,
+) ([]*TypeTrait, error) {
+	traits := make([]*TypeTrait, len(ids))
+	for i, id := range ids {
+		t, err := lk.typeByRef(id, referrer)
+		if err != nil {
+			return nil, err
+		}
+		trait, isTrait := t.(*TypeTrait)
+		if !isTrait {
+			return nil, fmt.Errorf(
+				"%s implements non-trait type %s",
+				referrer,
+				t,
+			)
+		}
+		traits[i] = trait
+	}
+	return traits, nil
+}
+
+// declareGraphNode registers a graph node by its unique identifier
+func (lk *modelLinker) declareGraphNode(id int, node GraphNode) error {
+	if id < 1 {
+		return fmt.Errorf(
+			"graph node %s has an illegal ID (%d)",
+			node.GraphNodeName(),
+			id,
+		)
+	}
+	if defined, isDefined := lk.graphNodeByID[GraphNodeID(id)]; isDefined {
+		return fmt.Errorf(
+			"graph nodes %s and %s share the same ID (%d)",
+			defined.GraphNodeName(),
+			node.GraphNodeName(),
+			id,
+		)
+	}
+	lk.graphNodeByID[GraphNodeID(id)] = node
+	lk.mod.GraphNodes = append(lk.mod.GraphNodes, node)
+	return nil
+}
+
+// declareTypes registers all types of the JSON model
+func (lk *modelLinker) declareTypes(model *JSONSchemaModel) error {
+	declare := func(id int, t Type, list *[]Type) error {
+		if err := lk.declareType(id, t); err != nil {
+			return err
+		}
+		*list = append(*list, t)
+		return nil
+	}
+	declared := func(
+		name string,
+		id int,
+		doc string,
+		deprecated *JSONModelDeprecation,
+		annotations []JSONModelAnnotation,
+	) terminalType {
+		return terminalType{
+			Name:        name,
+			ID:          TypeID(id),
+			Doc:         doc,
+			Deprecated:  linkDeprecation(deprecated),
+			Annotations: linkAnnotations(annotations),
+		}
+	}
+
+	for _, t := range model.AliasTypes {
+		if err := declare(t.ID, &TypeAlias{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+		}, &lk.mod.AliasTypes); err != nil {
+			return err
+		}
+	}
+	for _, t := range model.ScalarTypes {
+		if err := declare(t.ID, &TypeScalar{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+		}, &lk.mod.ScalarTypes); err != nil {
+			return err
+		}
+	}
+	for _, t := range model.EnumTypes {
+		if err := declare(t.ID, &TypeEnum{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+		}, &lk.mod.EnumTypes); err != nil {
+			return err
+		}
+	}
+	for _, t := range model.UnionTypes {
+		if err := declare(t.ID, &TypeUnion{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+		}, &lk.mod.UnionTypes); err != nil {
+			return err
+		}
+	}
+	for _, t := range model.StructTypes {
+		if err := declare(t.ID, &TypeStruct{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+		}, &lk.mod.StructTypes); err != nil {
+			return err
+		}
+	}
+	for _, t := range model.ResolverTypes {
+		if err := declare(t.ID, &TypeResolver{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+		}, &lk.mod.ResolverTypes); err != nil {
+			return err
+		}
+	}
+	for _, t := range model.TraitTypes {
+		if err := declare(t.ID, &TypeTrait{
+			terminalType: declared(
+				t.Name, t.ID, t.Doc, t.Deprecated, t.Annotations,
+			),
+			Pure: t.Pure,
+		}, &lk.mod.TraitTypes); err != nil {
+			return err
+		}
+	}
+
+	// Anonymous types are linked once all of them are declared
+	for _, t := range model.AnonymousTypes {
+		var newType Type
+		switch {
+		case t.KeyType != 0:
+			newType = &TypeMap{ID: TypeID(t.ID)}
+		case strings.HasPrefix(t.Designation, "?"):
+			newType = &TypeOptional{ID: TypeID(t.ID)}
+		case strings.HasPrefix(t.Designation, "[]"):
+			newType = &TypeList{ID: TypeID(t.ID)}
+		default:
+			return fmt.Errorf(
+				"anonymous type %d has an illegal designation (%q)",
+				t.ID,
+				t.Designation,
+			)
+		}
+		if TypeID(t.ID) <= TypeIDUserTypeOffset {
+			return fmt.Errorf(
+				"anonymous type %s has an illegal ID (%d)",
+				t.Designation,
+				t.ID,
+			)
+		}
+		if defined, isDefined := lk.typeByID[TypeID(t.ID)]; isDefined {
+			return fmt.Errorf(
+				"types %s and %s share the same ID (%d)",
+				defined,
+				t.Designation,
+				t.ID,
+			)
+		}
+		lk.typeByID[TypeID(t.ID)] = newType
+		lk.mod.Types = append(lk.mod.Types, newType)
+		lk.mod.AnonymousTypes = append(lk.mod.AnonymousTypes, newType)
+	}
+	return nil
+}
+
+// linkAnonymousTypes links the store-, key- and terminal types
+// of all anonymous types
+func (lk *modelLinker) linkAnonymousTypes(
+	types []JSONModelAnonymousType,
+) error {
+	for i, t := range types {
+		referrer := "anonymous type " + t.Designation
+		store, err := lk.typeByRef(t.StoreType, referrer)
+		if err != nil {
+			return err
+		}
+		switch v := lk.mod.AnonymousTypes[i].(type) {
+		case *TypeOptional:
+			v.StoreType = store
+		case *TypeList:
+			v.StoreType = store
+		case *TypeMap:
+			v.StoreType = store
+			if v.KeyType, err = lk.typeByRef(t.KeyType, referrer); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, t := range lk.mod.AnonymousTypes {
+		// Follow the store types to the terminal type
+		visited := map[Type]bool{}
+		terminal := t
+		for isAnonymous(terminal) {
+			if visited[terminal] {
+				return fmt.Errorf(
+					"anonymous type %s recursively stores itself",
+					types[i].Designation,
+				)
+			}
+			visited[terminal] = true
+			terminal = anonymousStoreType(terminal)
+		}
+		switch v := t.(type) {
+		case *TypeOptional:
+			v.Terminal = terminal
+		case *TypeList:
+			v.Terminal = terminal
+		case *TypeMap:
+			v.Terminal = terminal
+		}
+
+		if t.String() != types[i].Designation {
+			return fmt.Errorf(
+				"anonymous type %d is designated %q but stores %s",
+				types[i].ID,
+				types[i].Designation,
+				t,
+			)
+		}
+	}
+	return nil
+}
+
+// linkTypes links the references of all declared types
+func (lk *modelLinker) linkTypes(model *JSONSchemaModel) error {
+	var err error
+
+	for i, t := range model.AliasTypes {
+		v := lk.mod.AliasTypes[i].(*TypeAlias)
+		v.AliasedType, err = lk.typeByRef(t.AliasedTypeID, "alias "+t.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, t := range lk.mod.AliasTypes {
+		// Follow the aliased types to the first type that's not an alias
+		visited := map[Type]bool{}
+		for aliased := t; ; {
+			alias, isAlias := aliased.(*TypeAlias)
+			if !isAlias {
+				break
+			}
+			if visited[alias] {
+				return fmt.Errorf(
+					"alias %s recursively aliases itself",
+					t.(*TypeAlias).Name,
+				)
+			}
+			visited[alias] = true
+			aliased = alias.AliasedType
+		}
+	}
+
+	for i, t := range model.ScalarTypes {
+		v := lk.mod.ScalarTypes[i].(*TypeScalar)
+		v.BaseType, err = lk.typeByRef(t.BaseTypeID, "scalar "+t.Name)
+		if err != nil {
+			return err
+		}
+		if v.Constraints, err = linkConstraints(t.Constraints); err != nil {
+			return err
+		}
+	}
+
+	for i, t := range model.EnumTypes {
+		v := lk.mod.EnumTypes[i].(*TypeEnum)
+		v.Values = make([]*EnumValue, len(t.Values))
+		for j, name := range t.Values {
+			v.Values[j] = &EnumValue{
+				Name:        name,
+				Enum:        v,
+				Doc:         t.ValueDocs[name],
+				Annotations: linkAnnotations(t.ValueAnnotations[name]),
+			}
+		}
+	}
+
+	for i, t := range model.UnionTypes {
+		v := lk.mod.UnionTypes[i].(*TypeUnion)
+		v.Types = make([]Type, len(t.OptionTypes))
+		for j, id := range t.OptionTypes {
+			v.Types[j], err = lk.typeByRef(id, "union "+t.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, t := range model.StructTypes {
+		v := lk.mod.StructTypes[i].(*TypeStruct)
+		v.Fields = make([]*StructField, len(t.Fields))
+		for j, fld := range t.Fields {
+			newField := &StructField{
+				Struct:      v,
+				GraphID:     GraphNodeID(fld.GraphNodeID),
+				Name:        fld.Name,
+				Doc:         fld.Doc,
+				Deprecated:  linkDeprecation(fld.Deprecated),
+				Annotations: linkAnnotations(fld.Annotations),
+			}
+			referrer := "struct field " + newField.GraphNodeName()
+			if newField.Type, err = lk.typeByRef(fld.Type, referrer); err != nil {
+				return err
+			}
+			newField.Constraints, err = linkConstraints(fld.Constraints)
+			if err != nil {
+				return err
+			}
+			if err := lk.declareGraphNode(fld.GraphNodeID, newField); err != nil {
+				return err
+			}
+			v.Fields[j] = newField
+		}
+		v.Implements, err = lk.traitsByRef(t.Implements, "struct "+t.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, t := range model.ResolverTypes {
+		v := lk.mod.ResolverTypes[i].(*TypeResolver)
+		v.Properties = make([]*ResolverProperty, len(t.Properties))
+		for j, prop := range t.Properties {
+			newProp := &ResolverProperty{
+				Resolver:    v,
+				Name:        prop.Name,
+				GraphID:     GraphNodeID(prop.GraphNodeID),
+				Doc:         prop.Doc,
+				Deprecated:  linkDeprecation(prop.Deprecated),
+				Annotations: linkAnnotations(prop.Annotations),
+			}
+			referrer := "resolver property " + newProp.GraphNodeName()
+			if newProp.Type, err = lk.typeByRef(prop.Type, referrer); err != nil {
+				return err
+			}
+			if err := lk.declareGraphNode(prop.GraphNodeID, newProp); err != nil {
+				return err
+			}
+			newProp.Parameters, err = lk.linkParams(newProp, prop.Parameters)
+			if err != nil {
+				return err
+			}
+			v.Properties[j] = newProp
+		}
+		v.Implements, err = lk.traitsByRef(t.Implements, "resolver "+t.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, t := range model.TraitTypes {
+		v := lk.mod.TraitTypes[i].(*TypeTrait)
+		v.Properties = make([]*TraitProperty, len(t.Properties))
+		for j, prop := range t.Properties {
+			newProp := &TraitProperty{
+				Trait:       v,
+				Name:        prop.Name,
+				GraphID:     GraphNodeID(prop.GraphNodeID),
+				Doc:         prop.Doc,
+				Deprecated:  linkDeprecation(prop.Deprecated),
+				Annotations: linkAnnotations(prop.Annotations),
+			}
+			referrer := "trait property " + newProp.GraphNodeName()
+			if newProp.Type, err = lk.typeByRef(prop.Type, referrer); err != nil {
+				return err
+			}
+			if err := lk.declareGraphNode(prop.GraphNodeID, newProp); err != nil {
+				return err
+			}
+			newProp.Parameters, err = lk.linkParams(newProp, prop.Parameters)
+			if err != nil {
+				return err
+			}
+			v.Properties[j] = newProp
+		}
+		v.Implementations = make([]Type, len(t.Implementations))
+		for j, id := range t.Implementations {
+			v.Implementations[j], err = lk.typeByRef(id, "trait "+t.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// linkEndpoints links all query endpoints, mutations and subscriptions
+func (lk *modelLinker) linkEndpoints(model *JSONSchemaModel) error {
+	var err error
+
+	for _, q := range model.QueryEndpoints {
+		newQuery := &Query{
+			Name:        q.Name,
+			GraphID:     GraphNodeID(q.GraphNodeID),
+			Doc:         q.Doc,
+			Deprecated:  linkDeprecation(q.Deprecated),
+			Annotations: linkAnnotations(q.Annotations),
+		}
+		if newQuery.Type, err = lk.typeByRef(q.Type, "query "+q.Name); err != nil {
+			return err
+		}
+		if err := lk.declareGraphNode(q.GraphNodeID, newQuery); err != nil {
+			return err
+		}
+		newQuery.Parameters, err = lk.linkParams(newQuery, q.Parameters)
+		if err != nil {
+			return err
+		}
+		lk.mod.QueryEndpoints = append(lk.mod.QueryEndpoints, newQuery)
+	}
+
+	for _, m := range model.Mutations {
+		newMutation := &Mutation{
+			Name:        m.Name,
+			GraphID:     GraphNodeID(m.GraphNodeID),
+			Doc:         m.Doc,
+			Deprecated:  linkDeprecation(m.Deprecated),
+			Annotations: linkAnnotations(m.Annotations),
+		}
+		newMutation.Type, err = lk.typeByRef(m.Type, "mutation "+m.Name)
+		if err != nil {
+			return err
+		}
+		if err := lk.declareGraphNode(m.GraphNodeID, newMutation); err != nil {
+			return err
+		}
+		newMutation.Parameters, err = lk.linkParams(newMutation, m.Parameters)
+		if err != nil {
+			return err
+		}
+		lk.mod.Mutations = append(lk.mod.Mutations, newMutation)
+	}
+
+	for _, s := range model.Subscriptions {
+		newSubscription := &Subscription{
+			Name:        s.Name,
+			GraphID:     GraphNodeID(s.GraphNodeID),
+			Doc:         s.Doc,
+			Deprecated:  linkDeprecation(s.Deprecated),
+			Annotations: linkAnnotations(s.Annotations),
+		}
+		newSubscription.Type, err = lk.typeByRef(
+			s.Type,
+			"subscription "+s.Name,
+		)
+		if err != nil {
+			return err
+		}
+		if err := lk.declareGraphNode(
+			s.GraphNodeID,
+			newSubscription,
+		); err != nil {
+			return err
+		}
+		newSubscription.Parameters, err = lk.linkParams(
+			newSubscription,
+			s.Parameters,
+		)
+		if err != nil {
+			return err
+		}
+		lk.mod.Subscriptions = append(lk.mod.Subscriptions, newSubscription)
+	}
+	return nil
+}
+
+// linkParams links the parameters of the given graph node
+func (lk *modelLinker) linkParams(
+	target GraphNode,
+	params []JSONModelParameter,
+) ([]*Parameter, error) {
+	if len(params) < 1 {
+		return nil, nil
+	}
+	linked := make([]*Parameter, len(params))
+	for i, p := range params {
+		newParam := &Parameter{
+			Target:      target,
+			Name:        p.Name,
+			ID:          ParamID(p.GraphParamID),
+			Doc:         p.Doc,
+			Default:     p.Default,
+			Annotations: linkAnnotations(p.Annotations),
+		}
+		designation := paramDesignation(newParam)
+		if newParam.ID < 1 {
+			return nil, fmt.Errorf(
+				"parameter %s has an illegal ID (%d)",
+				designation,
+				newParam.ID,
+			)
+		}
+		if defined, isDefined := lk.paramByID[newParam.ID]; isDefined {
+			return nil, fmt.Errorf(
+				"parameters %s and %s share the same ID (%d)",
+				paramDesignation(defined),
+				designation,
+				newParam.ID,
+			)
+		}
+		var err error
+		newParam.Type, err = lk.typeByRef(p.Type, "parameter "+designation)
+		if err != nil {
+			return nil, err
+		}
+		if newParam.Constraints, err = linkConstraints(p.Constraints); err != nil {
+			return nil, err
+		}
+		lk.paramByID[newParam.ID] = newParam
+		lk.params = append(lk.params, newParam)
+		linked[i] = newParam
+	}
+	return linked, nil
+}
+
+// linkDeprecation returns the deprecation marker of the given JSON model
+func linkDeprecation(d *JSONModelDeprecation) *Deprecation {
+	if d == nil {
+		return nil
+	}
+	return &Deprecation{
+		Reason:      d.Reason,
+		Replacement: d.Replacement,
+	}
+}
+
+// linkAnnotations returns the annotations of the given JSON models
+func linkAnnotations(as []JSONModelAnnotation) []*Annotation {
+	if len(as) < 1 {
+		return nil
+	}
+	v := make([]*Annotation, len(as))
+	for i, a := range as {
+		v[i] = &Annotation{
+			Name: a.Name,
+			Args: a.Args,
+		}
+	}
+	return v
+}
+
+// linkConstraints returns the constraints of the given JSON models
+func linkConstraints(cs []JSONModelConstraint) ([]*Constraint, error) {
+	if len(cs) < 1 {
+		return nil, nil
+	}
+	v := make([]*Constraint, len(cs))
+	for i, c := range cs {
+		kind := constraintKindByName(c.Kind)
+		if kind == 0 {
+			return nil, fmt.Errorf("unknown constraint kind %q", c.Kind)
+		}
+		v[i] = &Constraint{
+			Kind:  kind,
+			Value: c.Value,
+		}
+	}
+	return v, nil
+}
+
+// isAnonymous returns true if the given type is an anonymous type
+func isAnonymous(t Type) bool {
+	switch t.(type) {
+	case *TypeOptional, *TypeList, *TypeMap:
+		return true
+	}
+	return false
+}
+
+// anonymousStoreType returns the store type of the given anonymous type
+func anonymousStoreType(t Type) Type {
+	switch v := t.(type) {
+	case *TypeOptional:
+		return v.StoreType
+	case *TypeList:
+		return v.StoreType
+	case *TypeMap:
+		return v.StoreType
+	}
+	return nil
+}
+
+// restoreEnumValues turns the string literals of the given value
+// into enum value literals where the given type expects enum values
+func restoreEnumValues(v *Value, t Type) {
+	if v == nil {
+		return
+	}
+	visited := map[*TypeAlias]bool{}
+	for {
+		if alias, isAlias := t.(*TypeAlias); isAlias {
+			if visited[alias] {
+				return
+			}
+			visited[alias] = true
+			t = alias.AliasedType
+			continue
+		}
+		if opt, isOptional := t.(*TypeOptional); isOptional {
+			t = opt.StoreType
+			continue
+		}
+		break
+	}
+
+	switch tp := t.(type) {
+	case *TypeEnum:
+		if v.Kind == ValueKindString {
+			v.Kind = ValueKindEnum
+		}
+	case *TypeList:
+		for _, item := range v.Items {
+			restoreEnumValues(item, tp.StoreType)
+		}
+	case *TypeStruct:
+		for _, fld := range v.Fields {
+			for _, structField := range tp.Fields {
+				if structField.Name == fld.Name {
+					restoreEnumValues(fld.Value, structField.Type)
+					break
+				}
+			}
+		}
+	}
+}
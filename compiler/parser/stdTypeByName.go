@@ -24,7 +24,56 @@ func stdTypeByName(name string) Type {
 		return TypeStdString{}
 	case "Time":
 		return TypeStdTime{}
+	case "Int8":
+		return TypeStdInt8{}
+	case "Int16":
+		return TypeStdInt16{}
+	case "Uint8":
+		return TypeStdUint8{}
+	case "Uint16":
+		return TypeStdUint16{}
+	case "Float32":
+		return TypeStdFloat32{}
+	case "Duration":
+		return TypeStdDuration{}
+	case "Date":
+		return TypeStdDate{}
+	case "UUID":
+		return TypeStdUUID{}
+	case "Decimal":
+		return TypeStdDecimal{}
 	default:
 		return nil
 	}
 }
+
+// stdTypeByID returns a standard primitive type instance by its unique
+// identifier or nil if id doesn't identify any built-in primitive type
+func stdTypeByID(id TypeID) Type {
+	for _, t := range []Type{
+		TypeStdNone{},
+		TypeStdBool{},
+		TypeStdByte{},
+		TypeStdInt32{},
+		TypeStdUint32{},
+		TypeStdInt64{},
+		TypeStdUint64{},
+		TypeStdFloat64{},
+		TypeStdString{},
+		TypeStdTime{},
+		TypeStdInt8{},
+		TypeStdInt16{},
+		TypeStdUint8{},
+		TypeStdUint16{},
+		TypeStdFloat32{},
+		TypeStdDuration{},
+		TypeStdDate{},
+		TypeStdUUID{},
+		TypeStdDecimal{},
+	} {
+		if t.TypeID() == id {
+			return t
+		}
+	}
+	return nil
+}
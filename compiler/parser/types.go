@@ -19,9 +19,12 @@ type Type interface {
 }
 
 type terminalType struct {
-	Src  Fragment
-	Name string
-	ID   TypeID
+	Src         Fragment
+	Name        string
+	ID          TypeID
+	Doc         string
+	Deprecated  *Deprecation
+	Annotations []*Annotation
 }
 
 func (i terminalType) Source() Fragment   { return i.Src }
@@ -29,6 +32,10 @@ func (i terminalType) String() string     { return i.Name }
 func (i terminalType) TerminalType() Type { return nil }
 func (i terminalType) TypeID() TypeID     { return i.ID }
 
+// deprecation returns the deprecation marker of the declared type
+// or nil if the type isn't deprecated
+func (i terminalType) deprecation() *Deprecation { return i.Deprecated }
+
 /****************************************************************
 	Alias
 ****************************************************************/
@@ -40,7 +47,63 @@ type TypeAlias struct {
 }
 
 // IsPure returns true if the aliased type is pure
-func (t *TypeAlias) IsPure() bool { return t.AliasedType.IsPure() }
+func (t *TypeAlias) IsPure() bool {
+	// Follow the alias chain considering unresolved
+	// and recursive aliases pure since they're reported elsewhere
+	visited := map[*TypeAlias]bool{}
+	var tp Type = t
+	for {
+		switch v := tp.(type) {
+		case nil:
+			return true
+		case *TypeAlias:
+			if visited[v] {
+				return true
+			}
+			visited[v] = true
+			tp = v.AliasedType
+		case *TypeList:
+			tp = v.Terminal
+		case *TypeOptional:
+			tp = v.Terminal
+		case *TypeMap:
+			tp = v.Terminal
+		default:
+			return tp.IsPure()
+		}
+	}
+}
+
+/****************************************************************
+	Scalar
+****************************************************************/
+
+// TypeScalar represents a custom scalar type implementation.
+// Scalar types are nominally distinct from their base type
+// but share its literal representation
+type TypeScalar struct {
+	terminalType
+	BaseType    Type
+	Constraints []*Constraint
+}
+
+// IsPure always returns true for scalar types
+func (t *TypeScalar) IsPure() bool { return true }
+
+// isScalarBaseType returns true if the given type can be the base type
+// of a scalar type, which only primitives (except None)
+// and aliases of them can
+func isScalarBaseType(t Type) bool {
+	t = unaliased(t)
+	switch t.(type) {
+	case nil:
+		// Unresolved and recursive aliases are reported elsewhere
+		return true
+	case TypeStdNone:
+		return false
+	}
+	return stdTypeByName(t.String()) != nil
+}
 
 /****************************************************************
 	Union
@@ -68,9 +131,11 @@ func (t *TypeUnion) IsPure() bool {
 
 // EnumValue represents an enumeration value
 type EnumValue struct {
-	Src  Fragment
-	Name string
-	Enum *TypeEnum
+	Src         Fragment
+	Name        string
+	Enum        *TypeEnum
+	Doc         string
+	Annotations []*Annotation
 }
 
 // TypeEnum represents a standard scalar type implementation
@@ -142,6 +207,63 @@ func (t *TypeList) TypeID() TypeID { return t.ID }
 // IsPure returns true if the terminal type is pure
 func (t *TypeList) IsPure() bool { return t.Terminal.IsPure() }
 
+/****************************************************************
+	Map
+****************************************************************/
+
+// TypeMap represents a map type implementation
+type TypeMap struct {
+	Src       Fragment
+	ID        TypeID
+	KeyType   Type
+	StoreType Type
+	Terminal  Type
+}
+
+// Source implements the Type interface
+func (t *TypeMap) Source() Fragment { return t.Src }
+
+// Name implements the Type interface
+func (t *TypeMap) Name() string {
+	return "[" + t.KeyType.String() + "]" + t.StoreType.String()
+}
+
+// String implements the Type interface
+func (t *TypeMap) String() string { return stringifyType(t) }
+
+// TerminalType implements the Type interface
+func (t *TypeMap) TerminalType() Type { return t.Terminal }
+
+// TypeID returns the type's unique identifier
+func (t *TypeMap) TypeID() TypeID { return t.ID }
+
+// IsPure returns true if the terminal type is pure
+func (t *TypeMap) IsPure() bool { return t.Terminal.IsPure() }
+
+// isMapKeyType returns true if the given type can be used as a map key,
+// which only primitives (except None), scalars, enums
+// and aliases of them can
+func isMapKeyType(t Type) bool {
+	visited := map[*TypeAlias]bool{}
+	for {
+		switch v := t.(type) {
+		case *TypeAlias:
+			if v.AliasedType == nil || visited[v] {
+				// Unresolved and recursive aliases are reported elsewhere
+				return true
+			}
+			visited[v] = true
+			t = v.AliasedType
+		case *TypeEnum, *TypeScalar:
+			return true
+		case TypeStdNone:
+			return false
+		default:
+			return stdTypeByName(t.String()) != nil
+		}
+	}
+}
+
 /****************************************************************
 	Standard Bool
 ****************************************************************/
@@ -392,6 +514,233 @@ func (t TypeStdTime) TypeID() TypeID { return TypeIDPrimitiveTime }
 // IsPure always returns true for Time primitives
 func (t TypeStdTime) IsPure() bool { return true }
 
+/****************************************************************
+	Standard Int8
+****************************************************************/
+
+// TypeStdInt8 represents a standard scalar type implementation
+type TypeStdInt8 struct{}
+
+// Source implements the Type interface
+func (t TypeStdInt8) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdInt8) Name() string { return "Int8" }
+
+// String implements the Type interface
+func (t TypeStdInt8) String() string { return "Int8" }
+
+// TerminalType implements the Type interface
+func (t TypeStdInt8) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdInt8) TypeID() TypeID { return TypeIDPrimitiveInt8 }
+
+// IsPure always returns true for Int8 primitives
+func (t TypeStdInt8) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Int16
+****************************************************************/
+
+// TypeStdInt16 represents a standard scalar type implementation
+type TypeStdInt16 struct{}
+
+// Source implements the Type interface
+func (t TypeStdInt16) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdInt16) Name() string { return "Int16" }
+
+// String implements the Type interface
+func (t TypeStdInt16) String() string { return "Int16" }
+
+// TerminalType implements the Type interface
+func (t TypeStdInt16) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdInt16) TypeID() TypeID { return TypeIDPrimitiveInt16 }
+
+// IsPure always returns true for Int16 primitives
+func (t TypeStdInt16) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Uint8
+****************************************************************/
+
+// TypeStdUint8 represents a standard scalar type implementation
+type TypeStdUint8 struct{}
+
+// Source implements the Type interface
+func (t TypeStdUint8) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdUint8) Name() string { return "Uint8" }
+
+// String implements the Type interface
+func (t TypeStdUint8) String() string { return "Uint8" }
+
+// TerminalType implements the Type interface
+func (t TypeStdUint8) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdUint8) TypeID() TypeID { return TypeIDPrimitiveUint8 }
+
+// IsPure always returns true for Uint8 primitives
+func (t TypeStdUint8) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Uint16
+****************************************************************/
+
+// TypeStdUint16 represents a standard scalar type implementation
+type TypeStdUint16 struct{}
+
+// Source implements the Type interface
+func (t TypeStdUint16) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdUint16) Name() string { return "Uint16" }
+
+// String implements the Type interface
+func (t TypeStdUint16) String() string { return "Uint16" }
+
+// TerminalType implements the Type interface
+func (t TypeStdUint16) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdUint16) TypeID() TypeID { return TypeIDPrimitiveUint16 }
+
+// IsPure always returns true for Uint16 primitives
+func (t TypeStdUint16) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Float32
+****************************************************************/
+
+// TypeStdFloat32 represents a standard scalar type implementation
+type TypeStdFloat32 struct{}
+
+// Source implements the Type interface
+func (t TypeStdFloat32) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdFloat32) Name() string { return "Float32" }
+
+// String implements the Type interface
+func (t TypeStdFloat32) String() string { return "Float32" }
+
+// TerminalType implements the Type interface
+func (t TypeStdFloat32) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdFloat32) TypeID() TypeID { return TypeIDPrimitiveFloat32 }
+
+// IsPure always returns true for Float32 primitives
+func (t TypeStdFloat32) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Duration
+****************************************************************/
+
+// TypeStdDuration represents a standard scalar type implementation
+type TypeStdDuration struct{}
+
+// Source implements the Type interface
+func (t TypeStdDuration) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdDuration) Name() string { return "Duration" }
+
+// String implements the Type interface
+func (t TypeStdDuration) String() string { return "Duration" }
+
+// TerminalType implements the Type interface
+func (t TypeStdDuration) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdDuration) TypeID() TypeID { return TypeIDPrimitiveDuration }
+
+// IsPure always returns true for Duration primitives
+func (t TypeStdDuration) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Date
+****************************************************************/
+
+// TypeStdDate represents a standard scalar type implementation
+type TypeStdDate struct{}
+
+// Source implements the Type interface
+func (t TypeStdDate) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdDate) Name() string { return "Date" }
+
+// String implements the Type interface
+func (t TypeStdDate) String() string { return "Date" }
+
+// TerminalType implements the Type interface
+func (t TypeStdDate) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdDate) TypeID() TypeID { return TypeIDPrimitiveDate }
+
+// IsPure always returns true for Date primitives
+func (t TypeStdDate) IsPure() bool { return true }
+
+/****************************************************************
+	Standard UUID
+****************************************************************/
+
+// TypeStdUUID represents a standard scalar type implementation
+type TypeStdUUID struct{}
+
+// Source implements the Type interface
+func (t TypeStdUUID) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdUUID) Name() string { return "UUID" }
+
+// String implements the Type interface
+func (t TypeStdUUID) String() string { return "UUID" }
+
+// TerminalType implements the Type interface
+func (t TypeStdUUID) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdUUID) TypeID() TypeID { return TypeIDPrimitiveUUID }
+
+// IsPure always returns true for UUID primitives
+func (t TypeStdUUID) IsPure() bool { return true }
+
+/****************************************************************
+	Standard Decimal
+****************************************************************/
+
+// TypeStdDecimal represents a standard scalar type implementation.
+// Decimals are transmitted as strings in their literal representation
+// since converting them to floating point numbers may lose precision
+type TypeStdDecimal struct{}
+
+// Source implements the Type interface
+func (t TypeStdDecimal) Source() Fragment { return nil }
+
+// Name implements the Type interface
+func (t TypeStdDecimal) Name() string { return "Decimal" }
+
+// String implements the Type interface
+func (t TypeStdDecimal) String() string { return "Decimal" }
+
+// TerminalType implements the Type interface
+func (t TypeStdDecimal) TerminalType() Type { return nil }
+
+// TypeID returns the type's unique identifier
+func (t TypeStdDecimal) TypeID() TypeID { return TypeIDPrimitiveDecimal }
+
+// IsPure always returns true for Decimal primitives
+func (t TypeStdDecimal) IsPure() bool { return true }
+
 /****************************************************************
 	Struct
 ****************************************************************/
@@ -399,7 +748,8 @@ func (t TypeStdTime) IsPure() bool { return true }
 // TypeStruct represents a standard scalar type implementation
 type TypeStruct struct {
 	terminalType
-	Fields []*StructField
+	Fields     []*StructField
+	Implements []*TypeTrait
 }
 
 // IsPure always returns true for struct types
@@ -417,12 +767,24 @@ type Parameter struct {
 	Name   string
 	ID     ParamID
 	Type   Type
+	Doc    string
+
+	// Default is the default value of the parameter
+	// or nil if the parameter has no default value
+	Default *Value
+
+	Annotations []*Annotation
+	Constraints []*Constraint
 }
 
+// Source returns the source location of the declaration
+func (p *Parameter) Source() Fragment { return p.Src }
+
 // TypeResolver represents a resolver type
 type TypeResolver struct {
 	terminalType
 	Properties []*ResolverProperty
+	Implements []*TypeTrait
 }
 
 // IsPure always returns false for resolver types
@@ -432,9 +794,20 @@ func (t *TypeResolver) IsPure() bool { return false }
 	Trait
 ****************************************************************/
 
-// TypeTrait represents a standard scalar type implementation
+// TypeTrait represents a trait type
 type TypeTrait struct {
 	terminalType
-	Pure       bool
-	Properties []ResolverProperty
+
+	// Pure is true when all properties of the trait are parameterless
+	// and of pure types, which makes the trait implementable by structs
+	Pure bool
+
+	Properties []*TraitProperty
+
+	// Implementations lists all resolver and struct types
+	// implementing the trait
+	Implementations []Type
 }
+
+// IsPure returns true if the trait is pure
+func (t *TypeTrait) IsPure() bool { return t.Pure }
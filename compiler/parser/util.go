@@ -164,6 +164,13 @@ func sortMutationsByName(mutations []*Mutation) {
 	})
 }
 
+func sortSubscriptionsByName(subscriptions []*Subscription) {
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].GraphNodeName() <
+			subscriptions[j].GraphNodeName()
+	})
+}
+
 func stringifyType(t Type) (name string) {
 	if t == nil {
 		return
@@ -187,6 +194,19 @@ func stringifyType(t Type) (name string) {
 			t = v.StoreType
 			continue
 		}
+		if v, isMap := t.(*TypeMap); isMap {
+			key := "<unknown>"
+			if v.KeyType != nil {
+				key = v.KeyType.String()
+			}
+			if v.StoreType == nil {
+				name += "[" + key + "]<unknown>"
+				break
+			}
+			name += "[" + key + "]"
+			t = v.StoreType
+			continue
+		}
 		name += t.String()
 		break
 	}
@@ -201,7 +221,7 @@ func readWord(
 ) (*Token, Error) {
 	tk, err := lex.NextExpectSkip(
 		FragTkLatinAlphanum,
-		Skip{FragTkSpace},
+		Skip{FragTkSpace, FragTkComment},
 		"expected "+expectation,
 	)
 	if err != nil {
@@ -226,7 +246,7 @@ func readWordExact(
 ) (*Token, Error) {
 	tk, err := lex.NextExpectSkip(
 		FragTkLatinAlphanum,
-		Skip{FragTkSpace},
+		Skip{FragTkSpace, FragTkComment},
 		"expected "+expectation,
 	)
 	if err != nil {
@@ -254,7 +274,7 @@ func readToken(
 ) (*Token, Error) {
 	return lex.NextExpectSkip(
 		expectedFragID,
-		Skip{FragTkSpace},
+		Skip{FragTkSpace, FragTkComment},
 		"expected "+expectation,
 	)
 }
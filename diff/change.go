@@ -0,0 +1,70 @@
+package diff
+
+import "fmt"
+
+// ChangeKind represents the kind of a schema change
+type ChangeKind int
+
+const (
+	_ ChangeKind = iota
+
+	// Added indicates an added declaration
+	Added
+
+	// Removed indicates a removed declaration
+	Removed
+
+	// Changed indicates a modified declaration
+	Changed
+)
+
+// String stringifies the change kind
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	}
+	return ""
+}
+
+// Change represents a single schema change
+type Change struct {
+	Kind ChangeKind
+
+	// Subject designates the changed declaration (e.g. "field User.name")
+	Subject string
+
+	// Description describes the change
+	Description string
+
+	// Breaking is true if the change breaks existing clients
+	Breaking bool
+}
+
+// String stringifies the change
+func (c Change) String() string {
+	severity := "safe"
+	if c.Breaking {
+		severity = "breaking"
+	}
+	s := fmt.Sprintf("[%s] %s %s", severity, c.Kind, c.Subject)
+	if c.Description != "" {
+		s += " (" + c.Description + ")"
+	}
+	return s
+}
+
+// HasBreaking returns true if any of the given changes is breaking,
+// otherwise returns false
+func HasBreaking(changes []Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
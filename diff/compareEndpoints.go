@@ -0,0 +1,134 @@
+package diff
+
+import (
+	"fmt"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// endpoint represents either a query, a mutation or a subscription
+type endpoint struct {
+	t      parser.Type
+	params []*parser.Parameter
+}
+
+// compareEndpoints compares all query, mutation
+// and subscription endpoints
+func (d *differ) compareEndpoints(from, to *parser.SchemaModel) {
+	queries := func(mod *parser.SchemaModel) map[string]endpoint {
+		m := map[string]endpoint{}
+		for _, q := range mod.QueryEndpoints {
+			m[q.Name] = endpoint{q.Type, q.Parameters}
+		}
+		return m
+	}
+	mutations := func(mod *parser.SchemaModel) map[string]endpoint {
+		m := map[string]endpoint{}
+		for _, mut := range mod.Mutations {
+			m[mut.Name] = endpoint{mut.Type, mut.Parameters}
+		}
+		return m
+	}
+	subscriptions := func(mod *parser.SchemaModel) map[string]endpoint {
+		m := map[string]endpoint{}
+		for _, s := range mod.Subscriptions {
+			m[s.Name] = endpoint{s.Type, s.Parameters}
+		}
+		return m
+	}
+
+	d.compareEndpointSet(parser.KeywordQuery, queries(from), queries(to))
+	d.compareEndpointSet(
+		parser.KeywordMutation,
+		mutations(from),
+		mutations(to),
+	)
+	d.compareEndpointSet(
+		parser.KeywordSubscription,
+		subscriptions(from),
+		subscriptions(to),
+	)
+}
+
+// compareEndpointSet compares endpoints of the same kind
+func (d *differ) compareEndpointSet(kind string, o, n map[string]endpoint) {
+	oldNames, newNames := map[string]bool{}, map[string]bool{}
+	for name := range o {
+		oldNames[name] = true
+	}
+	for name := range n {
+		newNames[name] = true
+	}
+	for _, name := range sortedNames(oldNames, newNames) {
+		subject := kind + " " + name
+		switch {
+		case !newNames[name]:
+			d.add(Removed, true, subject, "")
+		case !oldNames[name]:
+			d.add(Added, false, subject, "")
+		default:
+			oe, ne := o[name], n[name]
+			if oe.t.String() != ne.t.String() {
+				d.add(Changed, true, subject, typeChange(oe.t, ne.t))
+			}
+			d.compareParams(name, oe.params, ne.params)
+		}
+	}
+}
+
+// compareParams compares the parameters of an endpoint or a property.
+// Added parameters are safe as long as they're optional
+// or have a default value
+func (d *differ) compareParams(owner string, o, n []*parser.Parameter) {
+	oldParams := map[string]*parser.Parameter{}
+	newParams := map[string]*parser.Parameter{}
+	oldNames, newNames := map[string]bool{}, map[string]bool{}
+	for _, p := range o {
+		oldParams[p.Name] = p
+		oldNames[p.Name] = true
+	}
+	for _, p := range n {
+		newParams[p.Name] = p
+		newNames[p.Name] = true
+	}
+	for _, name := range sortedNames(oldNames, newNames) {
+		subject := "parameter " + owner + "." + name
+		op, np := oldParams[name], newParams[name]
+		switch {
+		case np == nil:
+			d.add(Removed, true, subject, "")
+		case op == nil:
+			if isRequired(np) {
+				d.add(Added, true, subject, "required parameter")
+				continue
+			}
+			d.add(Added, false, subject, "")
+		case op.Type.String() != np.Type.String():
+			// Making a required parameter optional is safe
+			opt, isOpt := np.Type.(*parser.TypeOptional)
+			safe := isOpt && opt.StoreType.String() == op.Type.String()
+			d.add(Changed, !safe, subject, typeChange(op.Type, np.Type))
+		case op.Default != nil && np.Default == nil && isRequired(np):
+			d.add(Changed, true, subject, "default value removed")
+		case op.Default == nil && np.Default != nil:
+			d.add(Changed, false, subject, fmt.Sprintf(
+				"default value %s added",
+				defaultLiteral(np.Default),
+			))
+		}
+	}
+}
+
+// isRequired returns true if clients must pass the parameter
+func isRequired(p *parser.Parameter) bool {
+	return p.Default == nil && !isOptional(p.Type)
+}
+
+// defaultLiteral returns the JSON representation of a default value
+func defaultLiteral(v *parser.Value) string {
+	encoded, err := v.MarshalJSON()
+	if err != nil {
+		return v.Literal
+	}
+	return string(encoded)
+}
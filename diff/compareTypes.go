@@ -0,0 +1,226 @@
+package diff
+
+import (
+	"fmt"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// compareTypes compares all named user types
+func (d *differ) compareTypes(from, to *parser.SchemaModel) {
+	oldTypes, newTypes := userTypes(from), userTypes(to)
+	oldNames, newNames := map[string]bool{}, map[string]bool{}
+	for name := range oldTypes {
+		oldNames[name] = true
+	}
+	for name := range newTypes {
+		newNames[name] = true
+	}
+
+	for _, name := range sortedNames(oldNames, newNames) {
+		o, n := oldTypes[name], newTypes[name]
+		switch {
+		case n == nil:
+			d.add(Removed, true, typeKind(o)+" "+name, "")
+			continue
+		case o == nil:
+			d.add(Added, false, typeKind(n)+" "+name, "")
+			continue
+		case typeKind(o) != typeKind(n):
+			d.add(Changed, true, "type "+name, fmt.Sprintf(
+				"changed from %s to %s",
+				typeKind(o),
+				typeKind(n),
+			))
+			continue
+		}
+
+		switch o := o.(type) {
+		case *parser.TypeAlias:
+			n := n.(*parser.TypeAlias)
+			if o.AliasedType.String() != n.AliasedType.String() {
+				d.add(Changed, true, "alias "+name, fmt.Sprintf(
+					"aliased type changed from %s to %s",
+					o.AliasedType,
+					n.AliasedType,
+				))
+			}
+		case *parser.TypeScalar:
+			n := n.(*parser.TypeScalar)
+			if o.BaseType.String() != n.BaseType.String() {
+				d.add(Changed, true, "scalar "+name, fmt.Sprintf(
+					"base type changed from %s to %s",
+					o.BaseType,
+					n.BaseType,
+				))
+			}
+		case *parser.TypeEnum:
+			d.compareEnumVals(o, n.(*parser.TypeEnum))
+		case *parser.TypeUnion:
+			d.compareUnionOpts(o, n.(*parser.TypeUnion))
+		case *parser.TypeStruct:
+			d.compareStructFields(o, n.(*parser.TypeStruct))
+		case *parser.TypeResolver:
+			n := n.(*parser.TypeResolver)
+			oldProps := make([]property, len(o.Properties))
+			for i, p := range o.Properties {
+				oldProps[i] = property{p.Name, p.Type, p.Parameters}
+			}
+			newProps := make([]property, len(n.Properties))
+			for i, p := range n.Properties {
+				newProps[i] = property{p.Name, p.Type, p.Parameters}
+			}
+			d.compareProps(name, oldProps, newProps)
+		case *parser.TypeTrait:
+			n := n.(*parser.TypeTrait)
+			oldProps := make([]property, len(o.Properties))
+			for i, p := range o.Properties {
+				oldProps[i] = property{p.Name, p.Type, p.Parameters}
+			}
+			newProps := make([]property, len(n.Properties))
+			for i, p := range n.Properties {
+				newProps[i] = property{p.Name, p.Type, p.Parameters}
+			}
+			d.compareProps(name, oldProps, newProps)
+		}
+	}
+}
+
+// compareEnumVals compares the values of an enum type.
+// Added values break clients exhaustively handling received enums
+func (d *differ) compareEnumVals(o, n *parser.TypeEnum) {
+	oldVals, newVals := map[string]bool{}, map[string]bool{}
+	for _, v := range o.Values {
+		oldVals[v.Name] = true
+	}
+	for _, v := range n.Values {
+		newVals[v.Name] = true
+	}
+	name := o.Name
+	for _, val := range sortedNames(oldVals, newVals) {
+		subject := "enum value " + name + "." + val
+		switch {
+		case !newVals[val]:
+			d.add(Removed, true, subject, "")
+		case !oldVals[val]:
+			if d.isOutput(name) {
+				d.add(Added, true, subject, fmt.Sprintf(
+					"enum %s is received by clients",
+					name,
+				))
+				continue
+			}
+			d.add(Added, false, subject, "")
+		}
+	}
+}
+
+// compareUnionOpts compares the option types of a union type.
+// Added options break clients exhaustively handling received unions,
+// removed options break clients sending them
+func (d *differ) compareUnionOpts(o, n *parser.TypeUnion) {
+	oldOpts, newOpts := map[string]bool{}, map[string]bool{}
+	for _, t := range o.Types {
+		oldOpts[t.String()] = true
+	}
+	for _, t := range n.Types {
+		newOpts[t.String()] = true
+	}
+	name := o.Name
+	for _, opt := range sortedNames(oldOpts, newOpts) {
+		subject := "union option " + name + "." + opt
+		switch {
+		case !newOpts[opt]:
+			if d.isInput(name) {
+				d.add(Removed, true, subject, fmt.Sprintf(
+					"union %s is sent by clients",
+					name,
+				))
+				continue
+			}
+			d.add(Removed, false, subject, "")
+		case !oldOpts[opt]:
+			if d.isOutput(name) {
+				d.add(Added, true, subject, fmt.Sprintf(
+					"union %s is received by clients",
+					name,
+				))
+				continue
+			}
+			d.add(Added, false, subject, "")
+		}
+	}
+}
+
+// compareStructFields compares the fields of a struct type.
+// Added required fields break clients sending the struct
+func (d *differ) compareStructFields(o, n *parser.TypeStruct) {
+	oldFields := map[string]*parser.StructField{}
+	newFields := map[string]*parser.StructField{}
+	oldNames, newNames := map[string]bool{}, map[string]bool{}
+	for _, f := range o.Fields {
+		oldFields[f.Name] = f
+		oldNames[f.Name] = true
+	}
+	for _, f := range n.Fields {
+		newFields[f.Name] = f
+		newNames[f.Name] = true
+	}
+	name := o.Name
+	for _, fld := range sortedNames(oldNames, newNames) {
+		subject := "field " + name + "." + fld
+		of, nf := oldFields[fld], newFields[fld]
+		switch {
+		case nf == nil:
+			d.add(Removed, true, subject, "")
+		case of == nil:
+			if d.isInput(name) && !isOptional(nf.Type) {
+				d.add(Added, true, subject, fmt.Sprintf(
+					"required field of struct %s sent by clients",
+					name,
+				))
+				continue
+			}
+			d.add(Added, false, subject, "")
+		case of.Type.String() != nf.Type.String():
+			d.add(Changed, true, subject, typeChange(of.Type, nf.Type))
+		}
+	}
+}
+
+// property represents either a resolver or a trait property
+type property struct {
+	name   string
+	t      parser.Type
+	params []*parser.Parameter
+}
+
+// compareProps compares the properties of a resolver or trait type
+func (d *differ) compareProps(typeName string, o, n []property) {
+	oldProps, newProps := map[string]property{}, map[string]property{}
+	oldNames, newNames := map[string]bool{}, map[string]bool{}
+	for _, p := range o {
+		oldProps[p.name] = p
+		oldNames[p.name] = true
+	}
+	for _, p := range n {
+		newProps[p.name] = p
+		newNames[p.name] = true
+	}
+	for _, prop := range sortedNames(oldNames, newNames) {
+		name := typeName + "." + prop
+		subject := "property " + name
+		switch {
+		case !newNames[prop]:
+			d.add(Removed, true, subject, "")
+		case !oldNames[prop]:
+			d.add(Added, false, subject, "")
+		default:
+			op, np := oldProps[prop], newProps[prop]
+			if op.t.String() != np.t.String() {
+				d.add(Changed, true, subject, typeChange(op.t, np.t))
+			}
+			d.compareParams(name, op.params, np.params)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// differ compares two schema models
+type differ struct {
+	usage   map[string]usage
+	changes []Change
+}
+
+// Compare compares two schema models by name returning all changes
+// made to the old schema (from) in the new one (to)
+func Compare(from, to *parser.SchemaModel) []Change {
+	d := &differ{usage: usages(from)}
+	for name, u := range usages(to) {
+		d.usage[name] |= u
+	}
+
+	d.compareTypes(from, to)
+	d.compareEndpoints(from, to)
+	return d.changes
+}
+
+// add records a change
+func (d *differ) add(
+	kind ChangeKind,
+	breaking bool,
+	subject string,
+	description string,
+) {
+	d.changes = append(d.changes, Change{
+		Kind:        kind,
+		Subject:     subject,
+		Description: description,
+		Breaking:    breaking,
+	})
+}
+
+// isInput returns true if the type of the given name is sent by clients
+func (d *differ) isInput(name string) bool {
+	return d.usage[name]&usageInput != 0
+}
+
+// isOutput returns true if the type of the given name
+// is received by clients
+func (d *differ) isOutput(name string) bool {
+	return d.usage[name]&usageOutput != 0
+}
+
+// typeKind returns the declaration keyword of the given user type
+func typeKind(t parser.Type) string {
+	switch t.(type) {
+	case *parser.TypeAlias:
+		return parser.KeywordAlias
+	case *parser.TypeScalar:
+		return parser.KeywordScalar
+	case *parser.TypeEnum:
+		return parser.KeywordEnum
+	case *parser.TypeUnion:
+		return parser.KeywordUnion
+	case *parser.TypeStruct:
+		return parser.KeywordStruct
+	case *parser.TypeResolver:
+		return parser.KeywordResolver
+	case *parser.TypeTrait:
+		return parser.KeywordTrait
+	}
+	return "type"
+}
+
+// userTypes returns all named user types of the schema by name
+func userTypes(mod *parser.SchemaModel) map[string]parser.Type {
+	types := map[string]parser.Type{}
+	for _, t := range mod.Types {
+		if t.TerminalType() == nil {
+			types[t.String()] = t
+		}
+	}
+	return types
+}
+
+// sortedNames returns the union of the keys of both maps in ascending order
+func sortedNames(a, b map[string]bool) []string {
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if !a[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isOptional returns true if the given type is optional
+func isOptional(t parser.Type) bool {
+	_, optional := t.(*parser.TypeOptional)
+	return optional
+}
+
+// typeChange describes a change of a type designation
+func typeChange(from, to parser.Type) string {
+	return fmt.Sprintf("type changed from %s to %s", from, to)
+}
@@ -0,0 +1,186 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/romshark/gapi/diff"
+	"github.com/romshark/gapi/internal/gapitest"
+	"github.com/stretchr/testify/require"
+)
+
+func test(t *testing.T, from, to string, expected []string) {
+	changes := diff.Compare(gapitest.Compile(t, from), gapitest.Compile(t, to))
+	actual := make([]string, len(changes))
+	for i, c := range changes {
+		actual[i] = c.String()
+	}
+	require.Equal(t, expected, actual)
+}
+
+// TestNoChanges tests comparing identical schemas
+func TestNoChanges(t *testing.T) {
+	src := `schema test
+	enum E { a b }
+	struct S { e E }
+	query q(s S) E`
+	changes := diff.Compare(gapitest.Compile(t, src), gapitest.Compile(t, src))
+	require.Len(t, changes, 0)
+	require.False(t, diff.HasBreaking(changes))
+}
+
+// TestTypes tests type changes
+func TestTypes(t *testing.T) {
+	test(t, `schema test
+	alias A = String
+	scalar S String
+	struct T { s String }
+	struct Removed { s String }
+	query q(a A, s S, t T) Removed`, `schema test
+	alias A = Int32
+	scalar S Int64
+	resolver T { s String }
+	struct Added { s String }
+	query q(a A, s S) Added`, []string{
+		"[breaking] changed alias A (aliased type changed from String to Int32)",
+		"[safe] added struct Added",
+		"[breaking] removed struct Removed",
+		"[breaking] changed scalar S (base type changed from String to Int64)",
+		"[breaking] changed type T (changed from struct to resolver)",
+		"[breaking] changed query q (type changed from Removed to Added)",
+		"[breaking] removed parameter q.t",
+	})
+}
+
+// TestEnumVals tests enum value changes
+func TestEnumVals(t *testing.T) {
+	test(t, `schema test
+	enum In { a b }
+	enum Out { a b }
+	query q(in In) Out`, `schema test
+	enum In { a c }
+	enum Out { a c }
+	query q(in In) Out`, []string{
+		"[breaking] removed enum value In.b",
+		"[safe] added enum value In.c",
+		"[breaking] removed enum value Out.b",
+		"[breaking] added enum value Out.c (enum Out is received by clients)",
+	})
+}
+
+// TestUnionOpts tests union option changes
+func TestUnionOpts(t *testing.T) {
+	test(t, `schema test
+	alias ErrA = None
+	union In { String Int32 }
+	union Out { String ErrA }
+	mutation m(in In) Out`, `schema test
+	alias ErrA = None
+	alias ErrB = None
+	union In { String Bool }
+	union Out { String ErrA ErrB }
+	mutation m(in In) Out`, []string{
+		"[safe] added alias ErrB",
+		"[safe] added union option In.Bool",
+		"[breaking] removed union option In.Int32 (union In is sent by clients)",
+		"[breaking] added union option Out.ErrB " +
+			"(union Out is received by clients)",
+	})
+}
+
+// TestStructFields tests struct field changes
+func TestStructFields(t *testing.T) {
+	test(t, `schema test
+	struct In { a String b String }
+	struct Out { a String b String }
+	query q(in In) Out`, `schema test
+	struct In { a Int32 c String d ?String }
+	struct Out { a String c String }
+	query q(in In) Out`, []string{
+		"[breaking] changed field In.a (type changed from String to Int32)",
+		"[breaking] removed field In.b",
+		"[breaking] added field In.c " +
+			"(required field of struct In sent by clients)",
+		"[safe] added field In.d",
+		"[breaking] removed field Out.b",
+		"[safe] added field Out.c",
+	})
+}
+
+// TestProps tests resolver and trait property changes
+func TestProps(t *testing.T) {
+	test(t, `schema test
+	trait T { a String }
+	resolver R {
+		a String
+		b(x Int32) String
+		c String
+	}
+	query q1 R
+	query q2 T`, `schema test
+	trait T { a String b String }
+	resolver R {
+		a Int32
+		b(x Int32, y ?Int32, z Int32) String
+		d String
+	}
+	query q1 R
+	query q2 T`, []string{
+		"[breaking] changed property R.a (type changed from String to Int32)",
+		"[safe] added parameter R.b.y",
+		"[breaking] added parameter R.b.z (required parameter)",
+		"[breaking] removed property R.c",
+		"[safe] added property R.d",
+		"[safe] added property T.b",
+	})
+}
+
+// TestParams tests parameter changes
+func TestParams(t *testing.T) {
+	test(t, `schema test
+	query q(
+		a Int32,
+		b Int32,
+		c Int32,
+		d Int32 = 1,
+		e Int32,
+		removed String,
+	) String`, `schema test
+	query q(
+		a ?Int32,
+		b String,
+		c Int32 = 2,
+		d Int32,
+		e Int32,
+		optional ?String,
+		defaulted Bool = true,
+		required String,
+	) String`, []string{
+		"[safe] changed parameter q.a (type changed from Int32 to ?Int32)",
+		"[breaking] changed parameter q.b (type changed from Int32 to String)",
+		"[safe] changed parameter q.c (default value 2 added)",
+		"[breaking] changed parameter q.d (default value removed)",
+		"[safe] added parameter q.defaulted",
+		"[safe] added parameter q.optional",
+		"[breaking] removed parameter q.removed",
+		"[breaking] added parameter q.required (required parameter)",
+	})
+}
+
+// TestEndpoints tests endpoint changes
+func TestEndpoints(t *testing.T) {
+	test(t, `schema test
+	query q1 String
+	query q2 String
+	mutation m1 String
+	subscription s1 String`, `schema test
+	query q1 ?String
+	query q3 String
+	mutation m2 String
+	subscription s1 String`, []string{
+		"[breaking] changed query q1 (type changed from String to ?String)",
+		"[breaking] removed query q2",
+		"[safe] added query q3",
+		"[breaking] removed mutation m1",
+		"[safe] added mutation m2",
+	})
+}
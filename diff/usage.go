@@ -0,0 +1,87 @@
+package diff
+
+import "github.com/romshark/gapi/compiler/parser"
+
+// usage represents the ways a type is used by clients
+type usage int
+
+const (
+	// usageInput indicates a type sent by clients
+	usageInput usage = 1 << iota
+
+	// usageOutput indicates a type received by clients
+	usageOutput
+)
+
+// usages determines the usage of all user types of the schema by name
+func usages(mod *parser.SchemaModel) map[string]usage {
+	u := map[string]usage{}
+	var use func(t parser.Type, as usage)
+	useParams := func(params []*parser.Parameter) {
+		for _, param := range params {
+			use(param.Type, usageInput)
+		}
+	}
+	use = func(t parser.Type, as usage) {
+		switch v := t.(type) {
+		case nil:
+			return
+		case *parser.TypeOptional:
+			use(v.StoreType, as)
+			return
+		case *parser.TypeList:
+			use(v.StoreType, as)
+			return
+		case *parser.TypeMap:
+			use(v.KeyType, as)
+			use(v.StoreType, as)
+			return
+		}
+
+		name := t.String()
+		if u[name]&as != 0 {
+			return
+		}
+		u[name] |= as
+
+		switch v := t.(type) {
+		case *parser.TypeAlias:
+			use(v.AliasedType, as)
+		case *parser.TypeUnion:
+			for _, option := range v.Types {
+				use(option, as)
+			}
+		case *parser.TypeStruct:
+			for _, fld := range v.Fields {
+				use(fld.Type, as)
+			}
+		case *parser.TypeResolver:
+			for _, prop := range v.Properties {
+				use(prop.Type, as)
+				useParams(prop.Parameters)
+			}
+		case *parser.TypeTrait:
+			for _, prop := range v.Properties {
+				use(prop.Type, as)
+				useParams(prop.Parameters)
+			}
+			for _, impl := range v.Implementations {
+				use(impl, as)
+			}
+		}
+	}
+
+	for _, qry := range mod.QueryEndpoints {
+		useParams(qry.Parameters)
+		use(qry.Type, usageOutput)
+	}
+	for _, mut := range mod.Mutations {
+		useParams(mut.Parameters)
+		use(mut.Type, usageOutput)
+	}
+	for _, sub := range mod.Subscriptions {
+		useParams(sub.Parameters)
+		use(sub.Type, usageOutput)
+	}
+	return u
+}
@@ -0,0 +1,68 @@
+// Package generator provides helpers shared by the code generators
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// TypeName returns an identifier of the given type.
+// Type arguments of generic type instances are appended to the name
+// of the generic type (e.g. Page<[]User> becomes PageListUser)
+// and anonymous types are described by words (e.g. ?[]User becomes
+// OptListUser)
+func TypeName(t parser.Type) string {
+	name := t.String()
+	ident := strings.Builder{}
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; c {
+		case '?':
+			ident.WriteString("Opt")
+		case '[':
+			if i+1 < len(name) && name[i+1] == ']' {
+				ident.WriteString("List")
+				i++
+				continue
+			}
+			ident.WriteString("Map")
+		case ']', '<', '>', ',', ' ':
+		default:
+			ident.WriteByte(c)
+		}
+	}
+	return ident.String()
+}
+
+// Unaliased returns the type aliased by the given type
+// or the type itself if it's not an alias
+func Unaliased(t parser.Type) parser.Type {
+	visited := map[*parser.TypeAlias]bool{}
+	for {
+		alias, isAlias := t.(*parser.TypeAlias)
+		if !isAlias || visited[alias] {
+			return t
+		}
+		visited[alias] = true
+		t = alias.AliasedType
+	}
+}
+
+// Identifiers keeps track of the identifiers declared by generated code
+type Identifiers map[string]string
+
+// Declare declares an identifier for the given declaration
+// returning an error if it's already declared
+func (ids Identifiers) Declare(ident, declaration string) error {
+	if previous, isDeclared := ids[ident]; isDeclared {
+		return fmt.Errorf(
+			"identifier %s of %s collides with %s",
+			ident,
+			declaration,
+			previous,
+		)
+	}
+	ids[ident] = declaration
+	return nil
+}
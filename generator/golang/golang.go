@@ -0,0 +1,253 @@
+// Package golang generates Go server code from schema models
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// DefaultPackageName is the package name used when none is specified
+const DefaultPackageName = "api"
+
+// Options configures the generator
+type Options struct {
+	// PackageName is the name of the generated package,
+	// DefaultPackageName is used if it's empty
+	PackageName string
+}
+
+// goGenerator generates the Go source code of a schema model
+type goGenerator struct {
+	mod     *parser.SchemaModel
+	out     bytes.Buffer
+	imports map[string]struct{}
+
+	// stdTypes holds the names of the referenced primitive types
+	// represented by types declared in the generated package
+	stdTypes map[string]struct{}
+
+	// idents holds all declared package-level identifiers
+	idents generator.Identifiers
+}
+
+// Generate generates the Go source code of the given schema model.
+// Returns an error if the generated identifiers collide
+func Generate(mod *parser.SchemaModel, options Options) ([]byte, error) {
+	if options.PackageName == "" {
+		options.PackageName = DefaultPackageName
+	}
+	if !token.IsIdentifier(options.PackageName) {
+		return nil, fmt.Errorf(
+			"invalid package name: %q",
+			options.PackageName,
+		)
+	}
+
+	gen := &goGenerator{
+		mod:      mod,
+		imports:  make(map[string]struct{}),
+		stdTypes: make(map[string]struct{}),
+		idents:   make(generator.Identifiers),
+	}
+	for _, write := range []func() error{
+		gen.writeAliasTypes,
+		gen.writeScalarTypes,
+		gen.writeEnumTypes,
+		gen.writeUnionTypes,
+		gen.writeStructTypes,
+		gen.writeTraitTypes,
+		gen.writeResolverTypes,
+		gen.writeRoots,
+		gen.writeStdTypes,
+	} {
+		if err := write(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Write the file header
+	src := bytes.Buffer{}
+	src.WriteString("// Code generated by gapi. DO NOT EDIT.\n\n")
+	fmt.Fprintf(
+		&src,
+		"// Package %s implements the %s API\n",
+		options.PackageName,
+		mod.SchemaName,
+	)
+	fmt.Fprintf(&src, "package %s\n\n", options.PackageName)
+	if len(gen.imports) > 0 {
+		imports := make([]string, 0, len(gen.imports))
+		for path := range gen.imports {
+			imports = append(imports, path)
+		}
+		sort.Strings(imports)
+		src.WriteString("import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(gen.out.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %s", err)
+	}
+	return formatted, nil
+}
+
+// printf writes formatted code
+func (gen *goGenerator) printf(format string, v ...interface{}) {
+	fmt.Fprintf(&gen.out, format, v...)
+}
+
+// writeDoc writes the documentation and the deprecation notice
+// of a declaration as a comment
+func (gen *goGenerator) writeDoc(doc string, deprecated *parser.Deprecation) {
+	if doc != "" {
+		for _, line := range strings.Split(doc, "\n") {
+			gen.printf("// %s\n", line)
+		}
+	}
+	if deprecated != nil {
+		if doc != "" {
+			gen.printf("//\n")
+		}
+		gen.printf("// Deprecated: %s", deprecated.Reason)
+		if deprecated.Replacement != "" {
+			gen.printf(" (use %s instead)", deprecated.Replacement)
+		}
+		gen.printf("\n")
+	}
+}
+
+// commonInitialisms holds the initialisms
+// that Go identifiers spell in upper case
+var commonInitialisms = map[string]bool{
+	"ACL":   true,
+	"API":   true,
+	"ASCII": true,
+	"CPU":   true,
+	"CSS":   true,
+	"DNS":   true,
+	"EOF":   true,
+	"GUID":  true,
+	"HTML":  true,
+	"HTTP":  true,
+	"HTTPS": true,
+	"ID":    true,
+	"IP":    true,
+	"JSON":  true,
+	"QPS":   true,
+	"RAM":   true,
+	"RPC":   true,
+	"SLA":   true,
+	"SMTP":  true,
+	"SQL":   true,
+	"SSH":   true,
+	"TCP":   true,
+	"TLS":   true,
+	"TTL":   true,
+	"UDP":   true,
+	"UI":    true,
+	"UID":   true,
+	"URI":   true,
+	"URL":   true,
+	"UTF8":  true,
+	"UUID":  true,
+	"VM":    true,
+	"XML":   true,
+	"XMPP":  true,
+	"XSRF":  true,
+	"XSS":   true,
+}
+
+// words splits the given camel case name into its words
+// such that URLPath is split into URL and Path
+func words(name string) []string {
+	isUpper := func(c byte) bool { return c >= 'A' && c <= 'Z' }
+	isLower := func(c byte) bool { return c >= 'a' && c <= 'z' }
+	words := []string{}
+	begin := 0
+	for i := 1; i < len(name); i++ {
+		if !isUpper(name[i]) {
+			continue
+		}
+		if !isUpper(name[i-1]) || i+1 < len(name) && isLower(name[i+1]) {
+			words = append(words, name[begin:i])
+			begin = i
+		}
+	}
+	return append(words, name[begin:])
+}
+
+// initialism returns the given word in upper case and true
+// if it's a common initialism or the plural of one such as Ids,
+// otherwise returns the word unchanged and false
+func initialism(word string) (string, bool) {
+	upper := strings.ToUpper(word)
+	if commonInitialisms[upper] {
+		return upper, true
+	}
+	if singular := strings.TrimSuffix(word, "s"); singular != word &&
+		commonInitialisms[strings.ToUpper(singular)] {
+		return strings.ToUpper(singular) + "s", true
+	}
+	return word, false
+}
+
+// withInitialisms returns the given name with all words but the first
+// that are common initialisms in upper case such that userId
+// becomes userID
+func withInitialisms(name string) string {
+	w := words(name)
+	for i := 1; i < len(w); i++ {
+		w[i], _ = initialism(w[i])
+	}
+	return strings.Join(w, "")
+}
+
+// exported returns the exported Go identifier of the given name
+// spelling common initialisms in upper case
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	name = withInitialisms(name)
+	w := words(name)
+	if first, isInitialism := initialism(w[0]); isInitialism {
+		return first + name[len(w[0]):]
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// unexported returns the unexported Go identifier of the given name
+// spelling common initialisms in upper case unless they lead the name
+func unexported(name string) string {
+	if name == "" {
+		return name
+	}
+	name = withInitialisms(name)
+	w := words(name)
+	if _, isInitialism := initialism(w[0]); isInitialism {
+		return strings.ToLower(w[0]) + name[len(w[0]):]
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// paramName returns the Go identifier of the given parameter
+// spelling common initialisms in upper case
+// and avoiding keywords and the context argument
+func paramName(name string) string {
+	if token.IsKeyword(name) || name == "ctx" {
+		return name + "_"
+	}
+	return withInitialisms(name)
+}
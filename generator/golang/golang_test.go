@@ -0,0 +1,198 @@
+package golang_test
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"testing"
+
+	"github.com/romshark/gapi/generator/golang"
+	"github.com/romshark/gapi/internal/gapitest"
+	"github.com/stretchr/testify/require"
+)
+
+// typeCheck type-checks the generated source code
+// returning the package scope
+func typeCheck(t *testing.T, src []byte) *types.Scope {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "api.go", src, parser.ParseComments)
+	require.NoError(t, err, string(src))
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := config.Check("api", fset, []*ast.File{file}, nil)
+	require.NoError(t, err, string(src))
+	return pkg.Scope()
+}
+
+// TestGenerate tests generating Go code
+func TestGenerate(t *testing.T) {
+	mod := gapitest.Compile(t, `schema test
+	alias ErrNotFound = None
+	alias Colors = []Color
+	scalar Cents Int64
+	enum Color { red green }
+
+	# Item is an item
+	struct Item implements Named {
+		name String
+		color ?Color
+		prices [String]Cents
+		created Time
+		released Date
+		warranty ?Duration
+	}
+
+	trait Named {
+		name String
+	}
+
+	union Result {
+		Item
+		Shop
+		ErrNotFound
+		[]String
+	}
+
+	resolver Shop implements Named {
+		name String
+		items(colors Colors, type ?Color) []Item
+		id UUID
+		ownerIds(sessionId String, apiUrl ?String) []UUID
+	}
+
+	query shop(id UUID) ?Shop
+	query result Result
+	mutation buy(item Item, ctx String) ?ErrNotFound
+	subscription bought Item`)
+
+	src, err := golang.Generate(mod, golang.Options{PackageName: "api"})
+	require.NoError(t, err)
+	scope := typeCheck(t, src)
+
+	typeOf := func(name string) types.Type {
+		obj := scope.Lookup(name)
+		require.NotNil(t, obj, "missing %s", name)
+		return obj.Type()
+	}
+	underlying := func(name string) string {
+		return typeOf(name).Underlying().String()
+	}
+
+	require.Equal(t, "struct{}", underlying("ErrNotFound"))
+	require.Equal(t, "[]api.Color", underlying("Colors"))
+	require.Equal(t, "int64", underlying("Cents"))
+	require.Equal(t, "string", underlying("Color"))
+	// Ensure dates, durations and UUIDs are transmitted as strings
+	require.Equal(t, "string", underlying("Date"))
+	require.Equal(t, "string", underlying("Duration"))
+	require.Equal(t, "string", underlying("UUID"))
+	colorRed := scope.Lookup("ColorRed").(*types.Const)
+	require.Equal(t, `"red"`, colorRed.Val().String())
+	require.Equal(t, typeOf("Color"), typeOf("ColorGreen"))
+	require.Equal(t, "struct{"+
+		`Name string "json:\"name\""; `+
+		`Color *api.Color "json:\"color\""; `+
+		`Prices map[string]api.Cents "json:\"prices\""; `+
+		`Created time.Time "json:\"created\""; `+
+		`Released api.Date "json:\"released\""; `+
+		`Warranty *api.Duration "json:\"warranty\""}`,
+		underlying("Item"),
+	)
+
+	// Ensure the option types implement the sealed union
+	result := typeOf("Result").Underlying().(*types.Interface)
+	for _, option := range []string{
+		"Item",
+		"ResultShop",
+		"ErrNotFound",
+		"ResultListString",
+	} {
+		require.True(t, types.Implements(typeOf(option), result), option)
+	}
+	require.False(t, types.Implements(typeOf("Cents"), result))
+
+	// Ensure struct and resolver implementations satisfy the trait
+	named := typeOf("Named").Underlying().(*types.Interface)
+	require.True(t, types.Implements(typeOf("Shop"), named))
+	asNamed, _, _ := types.LookupFieldOrMethod(
+		typeOf("Item"),
+		true,
+		nil,
+		"AsNamed",
+	)
+	require.NotNil(t, asNamed)
+
+	// Ensure the method signatures mirror the parameters
+	method := func(iface, name string) string {
+		obj, _, _ := types.LookupFieldOrMethod(typeOf(iface), true, nil, name)
+		require.NotNil(t, obj, "missing %s.%s", iface, name)
+		return obj.Type().String()
+	}
+	require.Equal(t,
+		"func(ctx context.Context, colors api.Colors, type_ *api.Color) "+
+			"([]api.Item, error)",
+		method("Shop", "Items"),
+	)
+	require.Equal(t,
+		"func(ctx context.Context) (api.UUID, error)",
+		method("Shop", "ID"),
+	)
+	require.Equal(t,
+		"func(ctx context.Context, sessionID string, apiURL *string) "+
+			"([]api.UUID, error)",
+		method("Shop", "OwnerIDs"),
+	)
+	require.Equal(t,
+		"func(ctx context.Context, id api.UUID) (api.Shop, error)",
+		method("Query", "Shop"),
+	)
+	require.Equal(t,
+		"func(ctx context.Context) (api.Result, error)",
+		method("Query", "Result"),
+	)
+	require.Equal(t,
+		"func(ctx context.Context, item api.Item, ctx_ string) "+
+			"(*api.ErrNotFound, error)",
+		method("Mutation", "Buy"),
+	)
+	require.Equal(t,
+		"func(ctx context.Context) (<-chan api.Item, error)",
+		method("Subscription", "Bought"),
+	)
+}
+
+// TestGenerateExample tests generating Go code for the example schema
+func TestGenerateExample(t *testing.T) {
+	src, err := ioutil.ReadFile("../../example/filesystem.gapi")
+	require.NoError(t, err)
+	generated, err := golang.Generate(
+		gapitest.Compile(t, string(src)),
+		golang.Options{},
+	)
+	require.NoError(t, err)
+	scope := typeCheck(t, generated)
+	require.NotNil(t, scope.Lookup("User"))
+	require.NotNil(t, scope.Lookup("Query"))
+	require.NotNil(t, scope.Lookup("Mutation"))
+}
+
+// TestGenerateErrs tests generator errors
+func TestGenerateErrs(t *testing.T) {
+	_, err := golang.Generate(gapitest.Compile(t, `schema test
+	struct Query { s String }
+	query q Query`), golang.Options{})
+	require.Error(t, err)
+
+	_, err = golang.Generate(gapitest.Compile(t, `schema test
+	enum E { a b }
+	struct EB { s String }
+	query q(e E) EB`), golang.Options{})
+	require.Error(t, err)
+
+	_, err = golang.Generate(gapitest.Compile(t, `schema test
+	struct S { s String }
+	query q S`), golang.Options{PackageName: "not valid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package golang
+
+import (
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// goType returns the Go type expression of the given type.
+// Optionals are represented by pointers except for interface types
+// which are nil when absent
+func (gen *goGenerator) goType(t parser.Type) string {
+	switch v := t.(type) {
+	case *parser.TypeOptional:
+		if isInterface(v.StoreType) {
+			return gen.goType(v.StoreType)
+		}
+		return "*" + gen.goType(v.StoreType)
+	case *parser.TypeList:
+		return "[]" + gen.goType(v.StoreType)
+	case *parser.TypeMap:
+		return "map[" + gen.goType(v.KeyType) + "]" + gen.goType(v.StoreType)
+	case parser.TypeStdNone:
+		return "struct{}"
+	case parser.TypeStdBool:
+		return "bool"
+	case parser.TypeStdByte:
+		return "byte"
+	case parser.TypeStdInt8:
+		return "int8"
+	case parser.TypeStdInt16:
+		return "int16"
+	case parser.TypeStdInt32:
+		return "int32"
+	case parser.TypeStdInt64:
+		return "int64"
+	case parser.TypeStdUint8:
+		return "uint8"
+	case parser.TypeStdUint16:
+		return "uint16"
+	case parser.TypeStdUint32:
+		return "uint32"
+	case parser.TypeStdUint64:
+		return "uint64"
+	case parser.TypeStdFloat32:
+		return "float32"
+	case parser.TypeStdFloat64:
+		return "float64"
+	case parser.TypeStdString:
+		return "string"
+	case parser.TypeStdTime:
+		gen.imports["time"] = struct{}{}
+		return "time.Time"
+	case parser.TypeStdDate, parser.TypeStdDuration, parser.TypeStdUUID:
+		// Dates, durations and UUIDs are transmitted as strings
+		// and are represented by string types declared on demand
+		gen.stdTypes[v.String()] = struct{}{}
+		return v.String()
+	case parser.TypeStdDecimal:
+		// See parser.TypeStdDecimal
+		return "string"
+	}
+	return generator.TypeName(t)
+}
+
+// isInterface returns true if the given type is represented
+// by a Go interface type
+func isInterface(t parser.Type) bool {
+	switch v := generator.Unaliased(t).(type) {
+	case *parser.TypeResolver, *parser.TypeTrait, *parser.TypeUnion:
+		return true
+	case *parser.TypeOptional:
+		return isInterface(v.StoreType)
+	}
+	return false
+}
+
+// isDeclared returns true if the given type is represented by a Go type
+// declared in the generated package
+func isDeclared(t parser.Type) bool {
+	switch t.(type) {
+	case *parser.TypeAlias,
+		*parser.TypeScalar,
+		*parser.TypeEnum,
+		*parser.TypeUnion,
+		*parser.TypeStruct,
+		*parser.TypeResolver,
+		*parser.TypeTrait:
+		return true
+	}
+	return false
+}
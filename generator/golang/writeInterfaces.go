@@ -0,0 +1,145 @@
+package golang
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// writeMethod writes the interface method resolving a graph node
+func (gen *goGenerator) writeMethod(
+	name string,
+	params []*parser.Parameter,
+	result string,
+	doc string,
+	deprecated *parser.Deprecation,
+) {
+	gen.imports["context"] = struct{}{}
+	args := make([]string, len(params)+1)
+	args[0] = "ctx context.Context"
+	for i, param := range params {
+		args[i+1] = paramName(param.Name) + " " + gen.goType(param.Type)
+	}
+	gen.writeDoc(doc, deprecated)
+	gen.printf(
+		"%s(%s) (%s, error)\n",
+		exported(name),
+		strings.Join(args, ", "),
+		result,
+	)
+}
+
+// writeTraitTypes writes interfaces for all trait types
+func (gen *goGenerator) writeTraitTypes() error {
+	for _, t := range gen.mod.TraitTypes {
+		v := t.(*parser.TypeTrait)
+		if err := gen.idents.Declare(generator.TypeName(v), "trait "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("type %s interface {\n", generator.TypeName(v))
+		for _, prop := range v.Properties {
+			gen.writeMethod(
+				prop.Name,
+				prop.Parameters,
+				gen.goType(prop.Type),
+				prop.Doc,
+				prop.Deprecated,
+			)
+		}
+		gen.printf("}\n\n")
+	}
+	return nil
+}
+
+// writeResolverTypes writes interfaces for all resolver types
+func (gen *goGenerator) writeResolverTypes() error {
+	for _, t := range gen.mod.ResolverTypes {
+		v := t.(*parser.TypeResolver)
+		if err := gen.idents.Declare(generator.TypeName(v), "resolver "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("type %s interface {\n", generator.TypeName(v))
+		for _, trait := range v.Implements {
+			gen.printf("%s\n", generator.TypeName(trait))
+		}
+		if len(v.Implements) > 0 {
+			gen.printf("\n")
+		}
+		for _, prop := range v.Properties {
+			gen.writeMethod(
+				prop.Name,
+				prop.Parameters,
+				gen.goType(prop.Type),
+				prop.Doc,
+				prop.Deprecated,
+			)
+		}
+		gen.printf("}\n\n")
+	}
+	return nil
+}
+
+// writeRoots writes the root interfaces of the query endpoints,
+// mutations and subscriptions
+func (gen *goGenerator) writeRoots() error {
+	if len(gen.mod.QueryEndpoints) > 0 {
+		if err := gen.idents.Declare("Query", "the query root"); err != nil {
+			return err
+		}
+		gen.printf("// Query resolves the query endpoints\n")
+		gen.printf("type Query interface {\n")
+		for _, q := range gen.mod.QueryEndpoints {
+			gen.writeMethod(
+				q.Name,
+				q.Parameters,
+				gen.goType(q.Type),
+				q.Doc,
+				q.Deprecated,
+			)
+		}
+		gen.printf("}\n\n")
+	}
+
+	if len(gen.mod.Mutations) > 0 {
+		if err := gen.idents.Declare("Mutation", "the mutation root"); err != nil {
+			return err
+		}
+		gen.printf("// Mutation executes the mutations\n")
+		gen.printf("type Mutation interface {\n")
+		for _, m := range gen.mod.Mutations {
+			gen.writeMethod(
+				m.Name,
+				m.Parameters,
+				gen.goType(m.Type),
+				m.Doc,
+				m.Deprecated,
+			)
+		}
+		gen.printf("}\n\n")
+	}
+
+	if len(gen.mod.Subscriptions) > 0 {
+		if err := gen.idents.Declare(
+			"Subscription",
+			"the subscription root",
+		); err != nil {
+			return err
+		}
+		gen.printf("// Subscription subscribes to the subscriptions\n")
+		gen.printf("type Subscription interface {\n")
+		for _, s := range gen.mod.Subscriptions {
+			gen.writeMethod(
+				s.Name,
+				s.Parameters,
+				"<-chan "+gen.goType(s.Type),
+				s.Doc,
+				s.Deprecated,
+			)
+		}
+		gen.printf("}\n\n")
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package golang
+
+import (
+	"sort"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// writeAliasTypes writes named types for all alias types
+func (gen *goGenerator) writeAliasTypes() error {
+	for _, t := range gen.mod.AliasTypes {
+		v := t.(*parser.TypeAlias)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "alias "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("type %s %s\n\n", name, gen.goType(v.AliasedType))
+	}
+	return nil
+}
+
+// writeScalarTypes writes named types for all scalar types
+func (gen *goGenerator) writeScalarTypes() error {
+	for _, t := range gen.mod.ScalarTypes {
+		v := t.(*parser.TypeScalar)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "scalar "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("type %s %s\n\n", name, gen.goType(v.BaseType))
+	}
+	return nil
+}
+
+// writeEnumTypes writes string types and typed constants
+// for all enum types
+func (gen *goGenerator) writeEnumTypes() error {
+	for _, t := range gen.mod.EnumTypes {
+		v := t.(*parser.TypeEnum)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "enum "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("type %s string\n\n", name)
+
+		gen.printf("// Values of enum %s\n", name)
+		gen.printf("const (\n")
+		for _, val := range v.Values {
+			ident := name + exported(val.Name)
+			if err := gen.idents.Declare(
+				ident,
+				"enum value "+v.Name+"."+val.Name,
+			); err != nil {
+				return err
+			}
+			gen.writeDoc(val.Doc, nil)
+			gen.printf("%s %s = %q\n", ident, name, val.Name)
+		}
+		gen.printf(")\n\n")
+	}
+	return nil
+}
+
+// writeUnionTypes writes sealed interfaces for all union types.
+// Option types declared in the generated package implement the union
+// directly while all other option types are wrapped
+func (gen *goGenerator) writeUnionTypes() error {
+	for _, t := range gen.mod.UnionTypes {
+		v := t.(*parser.TypeUnion)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "union "+v.Name); err != nil {
+			return err
+		}
+		marker := "is" + name
+
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("//\n// %s is implemented by:\n", name)
+		options := make([]string, len(v.Types))
+		for i, opt := range v.Types {
+			if canHaveMethods(opt) {
+				options[i] = generator.TypeName(opt)
+			} else {
+				options[i] = name + generator.TypeName(opt)
+			}
+			gen.printf("//  - %s\n", options[i])
+		}
+		gen.printf("type %s interface {\n%s()\n}\n\n", name, marker)
+
+		for i, opt := range v.Types {
+			if !canHaveMethods(opt) {
+				if err := gen.idents.Declare(
+					options[i],
+					"option "+opt.String()+" of union "+v.Name,
+				); err != nil {
+					return err
+				}
+				gen.printf(
+					"// %s wraps option %s of union %s\n",
+					options[i],
+					opt,
+					name,
+				)
+				if isInterface(opt) {
+					gen.printf(
+						"type %s struct {\n%s\n}\n\n",
+						options[i],
+						gen.goType(opt),
+					)
+				} else {
+					gen.printf(
+						"type %s struct {\nValue %s\n}\n\n",
+						options[i],
+						gen.goType(opt),
+					)
+				}
+			}
+			gen.printf("func (%s) %s() {}\n\n", options[i], marker)
+		}
+	}
+	return nil
+}
+
+// writeStructTypes writes structs for all struct types
+func (gen *goGenerator) writeStructTypes() error {
+	for _, t := range gen.mod.StructTypes {
+		v := t.(*parser.TypeStruct)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "struct "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc(v.Doc, v.Deprecated)
+		gen.printf("type %s struct {\n", name)
+		for _, fld := range v.Fields {
+			gen.writeDoc(fld.Doc, fld.Deprecated)
+			gen.printf(
+				"%s %s `json:\"%s\"`\n",
+				exported(fld.Name),
+				gen.goType(fld.Type),
+				fld.Name,
+			)
+		}
+		gen.printf("}\n\n")
+
+		// Adapt the struct to the interfaces of the implemented traits
+		for _, trait := range v.Implements {
+			traitName := generator.TypeName(trait)
+			adapter := unexported(name) + "As" + traitName
+			if err := gen.idents.Declare(
+				adapter,
+				"adapter of struct "+v.Name+" to trait "+trait.Name,
+			); err != nil {
+				return err
+			}
+			gen.printf(
+				"// As%s adapts %s to the interface of trait %s\n",
+				traitName,
+				name,
+				traitName,
+			)
+			gen.printf(
+				"func (s %s) As%s() %s { return %s{s} }\n\n",
+				name,
+				traitName,
+				traitName,
+				adapter,
+			)
+			gen.printf("type %s struct{ s %s }\n\n", adapter, name)
+			for _, prop := range trait.Properties {
+				gen.imports["context"] = struct{}{}
+				gen.printf(
+					"func (a %s) %s(context.Context) (%s, error) {\n"+
+						"return a.s.%s, nil\n}\n\n",
+					adapter,
+					exported(prop.Name),
+					gen.goType(prop.Type),
+					exported(prop.Name),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// canHaveMethods returns true if the given type is represented
+// by a named non-interface non-pointer Go type declared
+// in the generated package
+func canHaveMethods(t parser.Type) bool {
+	if !isDeclared(t) || isInterface(t) {
+		return false
+	}
+	_, isOptional := generator.Unaliased(t).(*parser.TypeOptional)
+	return !isOptional
+}
+
+// stdTypeDocs holds the documentation of the types representing
+// primitive types that are transmitted as strings
+var stdTypeDocs = map[string]string{
+	"Date": "Date is an RFC3339 full-date such as 2006-01-02",
+	"Duration": "Duration is a duration in the format " +
+		"accepted by time.ParseDuration such as 1h30m",
+	"UUID": "UUID is a UUID in its canonical textual representation\n" +
+		"such as 123e4567-e89b-12d3-a456-426614174000",
+}
+
+// writeStdTypes writes string types for all referenced primitive types
+// that are transmitted as strings
+func (gen *goGenerator) writeStdTypes() error {
+	names := make([]string, 0, len(gen.stdTypes))
+	for name := range gen.stdTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := gen.idents.Declare(name, "primitive "+name); err != nil {
+			return err
+		}
+		gen.writeDoc(stdTypeDocs[name], nil)
+		gen.printf("type %s string\n\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package typescript
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// tsType returns the TypeScript type expression of the given type
+func tsType(t parser.Type) string {
+	switch v := t.(type) {
+	case *parser.TypeOptional:
+		return tsType(v.StoreType) + " | null"
+	case *parser.TypeList:
+		item := tsType(v.StoreType)
+		if strings.Contains(item, " | ") {
+			return "Array<" + item + ">"
+		}
+		return item + "[]"
+	case *parser.TypeMap:
+		// JSON object keys are always strings,
+		// enum keys are constrained to their values
+		key := generator.Unaliased(v.KeyType)
+		if _, isEnum := key.(*parser.TypeEnum); isEnum {
+			return "Partial<Record<" + tsType(v.KeyType) + ", " +
+				tsType(v.StoreType) + ">>"
+		}
+		return "Record<string, " + tsType(v.StoreType) + ">"
+	case parser.TypeStdNone:
+		return "null"
+	case parser.TypeStdBool:
+		return "boolean"
+	case parser.TypeStdByte,
+		parser.TypeStdInt8,
+		parser.TypeStdInt16,
+		parser.TypeStdInt32,
+		parser.TypeStdInt64,
+		parser.TypeStdUint8,
+		parser.TypeStdUint16,
+		parser.TypeStdUint32,
+		parser.TypeStdUint64,
+		parser.TypeStdFloat32,
+		parser.TypeStdFloat64:
+		return "number"
+	case parser.TypeStdString,
+		parser.TypeStdTime,
+		parser.TypeStdDate,
+		parser.TypeStdDuration,
+		parser.TypeStdUUID,
+		parser.TypeStdDecimal:
+		// Times, dates and durations are transmitted in their
+		// textual representation, see parser.TypeStdDecimal for decimals
+		return "string"
+	}
+	return generator.TypeName(t)
+}
+
+// isOptional returns true if the given parameter can be omitted
+func isOptional(param *parser.Parameter) bool {
+	if param.Default != nil {
+		return true
+	}
+	_, isOpt := generator.Unaliased(param.Type).(*parser.TypeOptional)
+	return isOpt
+}
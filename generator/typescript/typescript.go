@@ -0,0 +1,107 @@
+// Package typescript generates TypeScript client code from schema models
+package typescript
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// TagField is the name of the field tagging the options
+// of discriminated unions with the name of the option type
+const TagField = "__typename"
+
+// tsGenerator generates the TypeScript source code of a schema model
+type tsGenerator struct {
+	mod *parser.SchemaModel
+	out bytes.Buffer
+
+	// idents holds all declared module-level identifiers
+	idents generator.Identifiers
+}
+
+// Generate generates the TypeScript source code of the given schema model.
+// Returns an error if the generated identifiers collide
+func Generate(mod *parser.SchemaModel) ([]byte, error) {
+	gen := &tsGenerator{
+		mod:    mod,
+		idents: make(generator.Identifiers),
+	}
+	gen.printf("// Code generated by gapi. DO NOT EDIT.\n")
+	gen.printf("// Client of the %s API\n\n", mod.SchemaName)
+	for _, write := range []func() error{
+		gen.writeAliasTypes,
+		gen.writeScalarTypes,
+		gen.writeEnumTypes,
+		gen.writeUnionTypes,
+		gen.writeStructTypes,
+		gen.writeTraitTypes,
+		gen.writeResolverTypes,
+		gen.writeTransport,
+		gen.writeQueryEndpoints,
+		gen.writeMutations,
+	} {
+		if err := write(); err != nil {
+			return nil, err
+		}
+	}
+	return bytes.TrimRight(gen.out.Bytes(), "\n"), nil
+}
+
+// printf writes formatted code
+func (gen *tsGenerator) printf(format string, v ...interface{}) {
+	fmt.Fprintf(&gen.out, format, v...)
+}
+
+// writeDoc writes the documentation and the deprecation notice
+// of a declaration as a JSDoc comment indented by the given prefix
+func (gen *tsGenerator) writeDoc(
+	indent string,
+	doc string,
+	deprecated *parser.Deprecation,
+) {
+	if doc == "" && deprecated == nil {
+		return
+	}
+	gen.printf("%s/**\n", indent)
+	if doc != "" {
+		for _, line := range strings.Split(doc, "\n") {
+			gen.printf("%s * %s\n", indent, line)
+		}
+	}
+	if deprecated != nil {
+		gen.printf("%s * @deprecated %s", indent, deprecated.Reason)
+		if deprecated.Replacement != "" {
+			gen.printf(" (use %s instead)", deprecated.Replacement)
+		}
+		gen.printf("\n")
+	}
+	gen.printf("%s */\n", indent)
+}
+
+// reservedWords lists the reserved words of TypeScript
+// that can't be used as function or variable names
+var reservedWords = map[string]struct{}{
+	"break": {}, "case": {}, "catch": {}, "class": {}, "const": {},
+	"continue": {}, "debugger": {}, "default": {}, "delete": {}, "do": {},
+	"else": {}, "enum": {}, "export": {}, "extends": {}, "false": {},
+	"finally": {}, "for": {}, "function": {}, "if": {}, "import": {},
+	"in": {}, "instanceof": {}, "new": {}, "null": {}, "return": {},
+	"super": {}, "switch": {}, "this": {}, "throw": {}, "true": {},
+	"try": {}, "typeof": {}, "var": {}, "void": {}, "while": {},
+	"with": {}, "implements": {}, "interface": {}, "let": {},
+	"package": {}, "private": {}, "protected": {}, "public": {},
+	"static": {}, "yield": {}, "await": {},
+}
+
+// funcName returns the TypeScript function name of the given endpoint
+// avoiding reserved words
+func funcName(name string) string {
+	if _, isReserved := reservedWords[name]; isReserved {
+		return name + "_"
+	}
+	return name
+}
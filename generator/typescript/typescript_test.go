@@ -0,0 +1,125 @@
+package typescript_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/romshark/gapi/generator/typescript"
+	"github.com/romshark/gapi/internal/gapitest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate tests generating TypeScript code
+func TestGenerate(t *testing.T) {
+	src, err := typescript.Generate(gapitest.Compile(t, `schema test
+	alias ErrNotFound = None
+	scalar Cents Int64
+	enum Color { red green }
+
+	# Item is an item
+	struct Item implements Named {
+		name String
+		color ?Color
+		prices [Color]Cents
+		tags [String]?[]String
+	}
+
+	trait Named {
+		name String
+	}
+
+	union Result {
+		Item
+		ErrNotFound
+		[]String
+	}
+
+	resolver Shop implements Named {
+		name String
+		items(color ?Color) []Item
+	}
+
+	deprecated("use shops")
+	query shop(id UUID) ?Shop
+	query new(limit Uint32 = 10) []Shop
+	mutation buy(item Item, amount ?Uint32) Result`))
+	require.NoError(t, err)
+
+	require.Equal(t, `// Code generated by gapi. DO NOT EDIT.
+// Client of the test API
+
+export type ErrNotFound = null;
+
+export type Cents = number;
+
+export type Color = "red" | "green";
+
+export type Result =
+	| { __typename: "Item"; value: Item }
+	| { __typename: "ErrNotFound"; value: ErrNotFound }
+	| { __typename: "[]String"; value: string[] };
+
+/**
+ * Item is an item
+ */
+export interface Item extends Named {
+	name: string;
+	color: Color | null;
+	prices: Partial<Record<Color, Cents>>;
+	tags: Record<string, string[] | null>;
+}
+
+export interface Named {
+	name: string;
+}
+
+export interface Shop extends Named {
+	name: string;
+	items: Item[];
+}
+
+/**
+ * Transport executes API requests
+ */
+export interface Transport {
+	query<T>(endpoint: string, args: object): Promise<T>;
+	mutate<T>(mutation: string, args: object): Promise<T>;
+}
+
+export function new_(transport: Transport, args: { limit?: number } = {}): Promise<Shop[]> {
+	return transport.query("new", args);
+}
+
+/**
+ * @deprecated use shops
+ */
+export function shop(transport: Transport, args: { id: string }): Promise<Shop | null> {
+	return transport.query("shop", args);
+}
+
+export function buy(transport: Transport, args: { item: Item; amount?: number | null }): Promise<Result> {
+	return transport.mutate("buy", args);
+}`, string(src))
+}
+
+// TestGenerateExample tests generating TypeScript code
+// for the example schema
+func TestGenerateExample(t *testing.T) {
+	src, err := ioutil.ReadFile("../../example/filesystem.gapi")
+	require.NoError(t, err)
+	generated, err := typescript.Generate(gapitest.Compile(t, string(src)))
+	require.NoError(t, err)
+	require.Contains(t, string(generated), "export interface User {")
+	require.Contains(t, string(generated),
+		"export function user(transport: Transport, args: { id: ID }): "+
+			"Promise<User | null> {",
+	)
+}
+
+// TestGenerateErrs tests generator errors
+func TestGenerateErrs(t *testing.T) {
+	_, err := typescript.Generate(gapitest.Compile(t, `schema test
+	struct Transport { s String }
+	query q Transport`))
+	require.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package typescript
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// writeTransport writes the interface of the transport
+// the endpoint functions execute their requests through
+func (gen *tsGenerator) writeTransport() error {
+	if err := gen.idents.Declare("Transport", "the transport"); err != nil {
+		return err
+	}
+	gen.printf("/**\n * Transport executes API requests\n */\n")
+	gen.printf("export interface Transport {\n")
+	gen.printf("\tquery<T>(endpoint: string, args: object): Promise<T>;\n")
+	gen.printf("\tmutate<T>(mutation: string, args: object): Promise<T>;\n")
+	gen.printf("}\n\n")
+	return nil
+}
+
+// writeFunc writes the typed function executing a query or a mutation
+func (gen *tsGenerator) writeFunc(
+	method string,
+	name string,
+	params []*parser.Parameter,
+	result parser.Type,
+	doc string,
+	deprecated *parser.Deprecation,
+) error {
+	fn := funcName(name)
+	if err := gen.idents.Declare(fn, method+" "+name); err != nil {
+		return err
+	}
+
+	args := "{}"
+	signature := "transport: Transport"
+	if len(params) > 0 {
+		fields := make([]string, len(params))
+		allOptional := true
+		for i, param := range params {
+			if isOptional(param) {
+				fields[i] = param.Name + "?: " + tsType(param.Type)
+				continue
+			}
+			allOptional = false
+			fields[i] = param.Name + ": " + tsType(param.Type)
+		}
+		args = "args"
+		signature += ", args: { " + strings.Join(fields, "; ") + " }"
+		if allOptional {
+			signature += " = {}"
+		}
+	}
+
+	gen.writeDoc("", doc, deprecated)
+	gen.printf(
+		"export function %s(%s): Promise<%s> {\n",
+		fn,
+		signature,
+		tsType(result),
+	)
+	gen.printf("\treturn transport.%s(%q, %s);\n}\n\n", method, name, args)
+	return nil
+}
+
+// writeQueryEndpoints writes typed functions for all query endpoints
+func (gen *tsGenerator) writeQueryEndpoints() error {
+	for _, q := range gen.mod.QueryEndpoints {
+		if err := gen.writeFunc(
+			"query",
+			q.Name,
+			q.Parameters,
+			q.Type,
+			q.Doc,
+			q.Deprecated,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMutations writes typed functions for all mutations
+func (gen *tsGenerator) writeMutations() error {
+	for _, m := range gen.mod.Mutations {
+		if err := gen.writeFunc(
+			"mutate",
+			m.Name,
+			m.Parameters,
+			m.Type,
+			m.Doc,
+			m.Deprecated,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package typescript
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// writeAliasTypes writes type aliases for all alias types
+func (gen *tsGenerator) writeAliasTypes() error {
+	for _, t := range gen.mod.AliasTypes {
+		v := t.(*parser.TypeAlias)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "alias "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf("export type %s = %s;\n\n", name, tsType(v.AliasedType))
+	}
+	return nil
+}
+
+// writeScalarTypes writes type aliases for all scalar types
+func (gen *tsGenerator) writeScalarTypes() error {
+	for _, t := range gen.mod.ScalarTypes {
+		v := t.(*parser.TypeScalar)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "scalar "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf("export type %s = %s;\n\n", name, tsType(v.BaseType))
+	}
+	return nil
+}
+
+// writeEnumTypes writes string literal unions for all enum types
+func (gen *tsGenerator) writeEnumTypes() error {
+	for _, t := range gen.mod.EnumTypes {
+		v := t.(*parser.TypeEnum)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "enum "+v.Name); err != nil {
+			return err
+		}
+		values := make([]string, len(v.Values))
+		for i, val := range v.Values {
+			values[i] = `"` + val.Name + `"`
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf(
+			"export type %s = %s;\n\n",
+			name,
+			strings.Join(values, " | "),
+		)
+	}
+	return nil
+}
+
+// writeUnionTypes writes discriminated unions for all union types
+// tagging each option by the name of its type
+func (gen *tsGenerator) writeUnionTypes() error {
+	for _, t := range gen.mod.UnionTypes {
+		v := t.(*parser.TypeUnion)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "union "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf("export type %s =\n", name)
+		for i, opt := range v.Types {
+			gen.printf(
+				"\t| { %s: %q; value: %s }",
+				TagField,
+				opt.String(),
+				tsType(opt),
+			)
+			if i+1 < len(v.Types) {
+				gen.printf("\n")
+			}
+		}
+		gen.printf(";\n\n")
+	}
+	return nil
+}
+
+// writeStructTypes writes interfaces for all struct types
+func (gen *tsGenerator) writeStructTypes() error {
+	for _, t := range gen.mod.StructTypes {
+		v := t.(*parser.TypeStruct)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "struct "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf("export interface %s%s {\n", name, extends(v.Implements))
+		for _, fld := range v.Fields {
+			gen.writeDoc("\t", fld.Doc, fld.Deprecated)
+			gen.printf("\t%s: %s;\n", fld.Name, tsType(fld.Type))
+		}
+		gen.printf("}\n\n")
+	}
+	return nil
+}
+
+// writeTraitTypes writes interfaces for all trait types
+func (gen *tsGenerator) writeTraitTypes() error {
+	for _, t := range gen.mod.TraitTypes {
+		v := t.(*parser.TypeTrait)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "trait "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf("export interface %s {\n", name)
+		for _, prop := range v.Properties {
+			gen.writeDoc("\t", prop.Doc, prop.Deprecated)
+			gen.printf("\t%s: %s;\n", prop.Name, tsType(prop.Type))
+		}
+		gen.printf("}\n\n")
+	}
+	return nil
+}
+
+// writeResolverTypes writes interfaces for all resolver types
+// describing the data they resolve to
+func (gen *tsGenerator) writeResolverTypes() error {
+	for _, t := range gen.mod.ResolverTypes {
+		v := t.(*parser.TypeResolver)
+		name := generator.TypeName(v)
+		if err := gen.idents.Declare(name, "resolver "+v.Name); err != nil {
+			return err
+		}
+		gen.writeDoc("", v.Doc, v.Deprecated)
+		gen.printf("export interface %s%s {\n", name, extends(v.Implements))
+		for _, prop := range v.Properties {
+			gen.writeDoc("\t", prop.Doc, prop.Deprecated)
+			gen.printf("\t%s: %s;\n", prop.Name, tsType(prop.Type))
+		}
+		gen.printf("}\n\n")
+	}
+	return nil
+}
+
+// extends returns the extends clause of the given implemented traits
+func extends(traits []*parser.TypeTrait) string {
+	if len(traits) < 1 {
+		return ""
+	}
+	names := make([]string, len(traits))
+	for i, trait := range traits {
+		names[i] = generator.TypeName(trait)
+	}
+	return " extends " + strings.Join(names, ", ")
+}
@@ -0,0 +1,475 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// writeDoc writes the description of a declaration
+// as GAPI documentation lines
+func (im *importer) writeDoc(indent, description string) {
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			im.printf("%s#\n", indent)
+			continue
+		}
+		im.printf("%s# %s\n", indent, line)
+	}
+}
+
+// writeDeprecation writes the deprecation marker of a declaration
+// if it's marked deprecated reporting any other directives
+func (im *importer) writeDeprecation(
+	indent string,
+	subject string,
+	directives []*sdlDirective,
+) {
+	im.unsupportedDirectives(subject, directives)
+	d := directive(directives, "deprecated")
+	if d == nil {
+		return
+	}
+	reason := defaultDeprecationReason
+	if r := d.argument("reason"); r != nil && r.Kind == sdlValueString &&
+		r.Literal != "" {
+		reason = r.Literal
+	}
+	im.printf("%sdeprecated(%s)\n", indent, strconv.Quote(reason))
+}
+
+// unsupportedDirectives reports all applied directives
+// except for deprecations which are handled separately
+func (im *importer) unsupportedDirectives(
+	subject string,
+	directives []*sdlDirective,
+) {
+	for _, d := range directives {
+		if d.Name == "deprecated" {
+			continue
+		}
+		im.issue(subject, "directive @%s isn't supported", d.Name)
+	}
+}
+
+// undeprecatable reports the deprecation of a declaration
+// that can't be deprecated in GAPI and any other directives
+func (im *importer) undeprecatable(
+	subject string,
+	directives []*sdlDirective,
+) {
+	im.unsupportedDirectives(subject, directives)
+	if directive(directives, "deprecated") != nil {
+		im.issue(subject, "deprecation isn't supported")
+	}
+}
+
+// ref returns the GAPI type designation of the given type reference.
+// Nullable types are optional
+func (im *importer) ref(t *sdlType) (string, error) {
+	var ref string
+	if t.OfType != nil {
+		item, err := im.ref(t.OfType)
+		if err != nil {
+			return "", err
+		}
+		ref = "[]" + item
+	} else {
+		name, err := im.typeName(t.Name)
+		if err != nil {
+			return "", err
+		}
+		ref = name
+	}
+	if !t.NonNull {
+		return "?" + ref, nil
+	}
+	return ref, nil
+}
+
+// typeName returns the name of the GAPI type representing
+// the GraphQL type of the given name
+func (im *importer) typeName(name string) (string, error) {
+	if converted, isDefined := im.names[name]; isDefined {
+		return converted, nil
+	}
+	if builtin, isBuiltin := builtinScalars[name]; isBuiltin {
+		if name == "ID" {
+			im.usesID = true
+		}
+		return builtin, nil
+	}
+	if _, isRoot := im.roots[name]; isRoot {
+		return "", fmt.Errorf("root type %s can't be referenced", name)
+	}
+	return "", fmt.Errorf("undefined type %s", name)
+}
+
+// value returns the GAPI literal of the given value
+func (im *importer) value(v *sdlValue) (string, error) {
+	switch v.Kind {
+	case sdlValueString:
+		return strconv.Quote(v.Literal), nil
+	case sdlValueEnum:
+		return convertName(v.Literal, false)
+	case sdlValueList:
+		if len(v.Items) < 1 {
+			return "[]", nil
+		}
+		items := make([]string, len(v.Items))
+		for i, item := range v.Items {
+			var err error
+			if items[i], err = im.value(item); err != nil {
+				return "", err
+			}
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case sdlValueObject:
+		fields := make([]string, len(v.Fields))
+		for i, fld := range v.Fields {
+			name, err := convertName(fld.Name, false)
+			if err != nil {
+				return "", err
+			}
+			val, err := im.value(fld.Value)
+			if err != nil {
+				return "", err
+			}
+			fields[i] = name + ": " + val
+		}
+		return "{" + strings.Join(fields, ", ") + "}", nil
+	case sdlValueVariable:
+		return "", fmt.Errorf("unexpected variable $%s", v.Literal)
+	}
+	return v.Literal, nil
+}
+
+// params returns the GAPI parameter list of the given arguments
+// indented by the given prefix. Arguments are written on separate lines
+// unless there's only a single undocumented one
+func (im *importer) params(
+	indent string,
+	subject string,
+	args []*sdlInputValue,
+) (string, error) {
+	if len(args) < 1 {
+		return "", nil
+	}
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = arg.Name
+	}
+	names, err := im.memberNames(subject, names, false)
+	if err != nil {
+		return "", err
+	}
+
+	params := make([]string, len(args))
+	for i, arg := range args {
+		im.undeprecatable(subject+"."+arg.Name, arg.Directives)
+		t, err := im.ref(arg.Type)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %s", subject, arg.Name, err)
+		}
+		params[i] = names[i] + " " + t
+		if arg.Default != nil {
+			val, err := im.value(arg.Default)
+			if err != nil {
+				return "", fmt.Errorf("%s.%s: %s", subject, arg.Name, err)
+			}
+			params[i] += " = " + val
+		}
+	}
+	if len(args) == 1 && args[0].Description == "" {
+		return "(" + params[0] + ")", nil
+	}
+
+	b := strings.Builder{}
+	b.WriteString("(\n")
+	for i, arg := range args {
+		if arg.Description != "" {
+			for _, line := range strings.Split(arg.Description, "\n") {
+				b.WriteString(strings.TrimRight(
+					indent+"\t# "+strings.TrimRight(line, " \t\r"),
+					" ",
+				) + "\n")
+			}
+		}
+		b.WriteString(indent + "\t" + params[i] + ",\n")
+	}
+	b.WriteString(indent + ")")
+	return b.String(), nil
+}
+
+// convertScalar converts a custom scalar to an alias of String
+// unless it's represented by a primitive
+func (im *importer) convertScalar(def *sdlDefinition) {
+	name, isDeclared := im.names[def.Name]
+	if !isDeclared {
+		return
+	}
+	if isPrimitive(name) {
+		im.undeprecatable("scalar "+def.Name, def.Directives)
+		return
+	}
+	im.writeDoc("", def.Description)
+	im.writeDeprecation("", "scalar "+def.Name, def.Directives)
+	im.printf("alias %s = String\n\n", name)
+}
+
+// convertEnum converts an enum
+func (im *importer) convertEnum(def *sdlDefinition) error {
+	subject := "enum " + def.Name
+	names := make([]string, len(def.Values))
+	for i, val := range def.Values {
+		names[i] = val.Name
+	}
+	names, err := im.memberNames(subject, names, false)
+	if err != nil {
+		return err
+	}
+
+	im.writeDoc("", def.Description)
+	im.writeDeprecation("", subject, def.Directives)
+	im.printf("enum %s {\n", im.names[def.Name])
+	for i, val := range def.Values {
+		im.undeprecatable(subject+"."+val.Name, val.Directives)
+		im.writeDoc("\t", val.Description)
+		im.printf("\t%s\n", names[i])
+	}
+	im.printf("}\n\n")
+	return nil
+}
+
+// convertInput converts an input object to a struct
+func (im *importer) convertInput(def *sdlDefinition) error {
+	subject := "input " + def.Name
+	names := make([]string, len(def.InputFields))
+	for i, fld := range def.InputFields {
+		names[i] = fld.Name
+	}
+	names, err := im.memberNames(subject, names, false)
+	if err != nil {
+		return err
+	}
+
+	im.writeDoc("", def.Description)
+	im.writeDeprecation("", subject, def.Directives)
+	im.printf("struct %s {\n", im.names[def.Name])
+	for i, fld := range def.InputFields {
+		fldSubject := subject + "." + fld.Name
+		t, err := im.ref(fld.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fldSubject, err)
+		}
+		if fld.Default != nil {
+			im.issue(fldSubject, "default values of fields aren't supported")
+		}
+		im.writeDoc("\t", fld.Description)
+		im.writeDeprecation("\t", fldSubject, fld.Directives)
+		im.printf("\t%s %s\n", names[i], t)
+	}
+	im.printf("}\n\n")
+	return nil
+}
+
+// convertInterface converts an interface to a trait
+func (im *importer) convertInterface(def *sdlDefinition) error {
+	subject := "interface " + def.Name
+	if len(def.Interfaces) > 0 {
+		im.issue(subject, "interfaces implementing interfaces aren't supported")
+	}
+	im.writeDoc("", def.Description)
+	im.writeDeprecation("", subject, def.Directives)
+	im.printf("trait %s {\n", im.names[def.Name])
+	if err := im.convertFields(subject, def.Fields); err != nil {
+		return err
+	}
+	im.printf("}\n\n")
+	return nil
+}
+
+// convertObject converts an object to a resolver.
+// Interfaces are only implemented if the object provides
+// all of their fields with identical signatures as required by GAPI
+func (im *importer) convertObject(def *sdlDefinition) error {
+	subject := "type " + def.Name
+	if isPlaceholderObject(def) {
+		im.issue(subject, "converted to an alias of None")
+		im.writeDoc("", def.Description)
+		im.writeDeprecation("", subject, def.Directives)
+		im.printf("alias %s = None\n\n", im.names[def.Name])
+		return nil
+	}
+
+	traits := []string{}
+	for _, name := range def.Interfaces {
+		iface, isDefined := im.types[name]
+		if !isDefined || iface.Keyword != "interface" {
+			return fmt.Errorf("%s: undefined interface %s", subject, name)
+		}
+		if mismatch := im.mismatch(def, iface); mismatch != "" {
+			im.issue(
+				subject,
+				"interface %s isn't implemented because %s "+
+					"while GAPI requires identical signatures",
+				name,
+				mismatch,
+			)
+			continue
+		}
+		traits = append(traits, im.names[name])
+	}
+
+	im.writeDoc("", def.Description)
+	im.writeDeprecation("", subject, def.Directives)
+	im.printf("resolver %s", im.names[def.Name])
+	if len(traits) > 0 {
+		im.printf(" implements %s", strings.Join(traits, ", "))
+	}
+	im.printf(" {\n")
+	if err := im.convertFields(subject, def.Fields); err != nil {
+		return err
+	}
+	im.printf("}\n\n")
+	return nil
+}
+
+// isPlaceholderObject returns true if the given object
+// has only the placeholder field _ such as the objects
+// aliases of None are exported as
+func isPlaceholderObject(def *sdlDefinition) bool {
+	return len(def.Interfaces) < 1 &&
+		len(def.Fields) == 1 &&
+		def.Fields[0].Name == "_" &&
+		len(def.Fields[0].Arguments) < 1
+}
+
+// mismatch describes how the fields of the given object
+// differ from the fields of the given interface.
+// Returns an empty string if the object implements all fields
+// with identical types and arguments
+func (im *importer) mismatch(object, iface *sdlDefinition) string {
+	signature := func(fld *sdlField) string {
+		s := strings.Builder{}
+		for _, arg := range fld.Arguments {
+			t, _ := im.ref(arg.Type)
+			s.WriteString(arg.Name + " " + t + ",")
+		}
+		t, _ := im.ref(fld.Type)
+		s.WriteString(t)
+		return s.String()
+	}
+	for _, ifaceFld := range iface.Fields {
+		var fld *sdlField
+		for _, f := range object.Fields {
+			if f.Name == ifaceFld.Name {
+				fld = f
+				break
+			}
+		}
+		if fld == nil {
+			return "field " + ifaceFld.Name + " is missing"
+		}
+		if signature(fld) != signature(ifaceFld) {
+			return "the signature of field " + fld.Name + " differs"
+		}
+	}
+	return ""
+}
+
+// convertFields converts the fields of an object or an interface
+// to resolver or trait properties
+func (im *importer) convertFields(subject string, fields []*sdlField) error {
+	names := make([]string, len(fields))
+	for i, fld := range fields {
+		names[i] = fld.Name
+	}
+	names, err := im.memberNames(subject, names, false)
+	if err != nil {
+		return err
+	}
+	for i, fld := range fields {
+		fldSubject := subject + "." + fld.Name
+		params, err := im.params("\t", fldSubject, fld.Arguments)
+		if err != nil {
+			return err
+		}
+		t, err := im.ref(fld.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fldSubject, err)
+		}
+		im.writeDoc("\t", fld.Description)
+		im.writeDeprecation("\t", fldSubject, fld.Directives)
+		im.printf("\t%s%s %s\n", names[i], params, t)
+	}
+	return nil
+}
+
+// convertRoot converts the fields of a root type
+// to endpoints of the given operation type
+func (im *importer) convertRoot(def *sdlDefinition, operation string) error {
+	subject := "type " + def.Name
+	im.unsupportedDirectives(subject, def.Directives)
+	names := make([]string, len(def.Fields))
+	for i, fld := range def.Fields {
+		names[i] = fld.Name
+	}
+	names, err := im.memberNames(subject, names, false)
+	if err != nil {
+		return err
+	}
+	for i, fld := range def.Fields {
+		fldSubject := subject + "." + fld.Name
+		params, err := im.params("", fldSubject, fld.Arguments)
+		if err != nil {
+			return err
+		}
+		t, err := im.ref(fld.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fldSubject, err)
+		}
+		im.writeDoc("", fld.Description)
+		im.writeDeprecation("", fldSubject, fld.Directives)
+		im.printf("%s %s%s %s\n\n", operation, names[i], params, t)
+	}
+	return nil
+}
+
+// convertUnion converts a union. Unions of a single member
+// are converted to aliases since GAPI unions require at least 2 options
+func (im *importer) convertUnion(def *sdlDefinition) error {
+	subject := "union " + def.Name
+	members := make([]string, len(def.Members))
+	for i, member := range def.Members {
+		if m, isDefined := im.types[member]; !isDefined || m.Keyword != "type" {
+			return fmt.Errorf("%s: undefined object type %s", subject, member)
+		}
+		name, err := im.typeName(member)
+		if err != nil {
+			return fmt.Errorf("%s: %s", subject, err)
+		}
+		members[i] = name
+	}
+	if len(members) < 1 {
+		return fmt.Errorf("%s: missing member types", subject)
+	}
+
+	im.writeDoc("", def.Description)
+	im.writeDeprecation("", subject, def.Directives)
+	if len(members) < 2 {
+		im.issue(subject, "converted to an alias of its only member")
+		im.printf("alias %s = %s\n\n", im.names[def.Name], members[0])
+		return nil
+	}
+	im.printf("union %s {\n", im.names[def.Name])
+	for _, member := range members {
+		im.printf("\t%s\n", member)
+	}
+	im.printf("}\n\n")
+	return nil
+}
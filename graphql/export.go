@@ -0,0 +1,419 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// declare declares the GraphQL type of the given declaration
+// reporting deprecations which GraphQL doesn't support on types
+func (ex *exporter) declare(
+	name string,
+	declaration string,
+	doc string,
+	deprecation *parser.Deprecation,
+) error {
+	if err := ex.idents.Declare(name, declaration); err != nil {
+		return err
+	}
+	if deprecation != nil {
+		ex.issue(declaration, "types can't be deprecated")
+	}
+	ex.writeDescription("", doc)
+	return nil
+}
+
+// args returns the argument definitions of the given parameters
+func (ex *exporter) args(params []*parser.Parameter) string {
+	if len(params) < 1 {
+		return ""
+	}
+	args := make([]string, len(params))
+	documented := false
+	for i, param := range params {
+		subject := "parameter " + param.Target.GraphNodeName() +
+			"." + param.Name
+		if len(param.Constraints) > 0 {
+			ex.issue(subject, "constraints can't be represented")
+		}
+		args[i] = param.Name + ": " + ex.ref(param.Type, true, subject)
+		if param.Default != nil {
+			args[i] += " = " + value(param.Default)
+		}
+		if param.Doc != "" {
+			documented = true
+		}
+	}
+	if !documented {
+		return "(" + strings.Join(args, ", ") + ")"
+	}
+
+	// Write each argument on a separate line preceded by its description
+	b := strings.Builder{}
+	b.WriteString("(\n")
+	for i, param := range params {
+		if param.Doc != "" {
+			b.WriteString("\t\t" + quote(param.Doc) + "\n")
+		}
+		b.WriteString("\t\t" + args[i] + "\n")
+	}
+	b.WriteString("\t)")
+	return b.String()
+}
+
+// implements returns the implements clause of the given traits
+func implements(traits []*parser.TypeTrait) string {
+	if len(traits) < 1 {
+		return ""
+	}
+	names := make([]string, len(traits))
+	for i, trait := range traits {
+		names[i] = generator.TypeName(trait)
+	}
+	return " implements " + strings.Join(names, " & ")
+}
+
+// exportScalarTypes exports custom scalars for all scalar types
+func (ex *exporter) exportScalarTypes() error {
+	for _, t := range ex.mod.ScalarTypes {
+		v := t.(*parser.TypeScalar)
+		name := generator.TypeName(v)
+		if err := ex.declare(
+			name,
+			"scalar "+v.Name,
+			v.Doc,
+			v.Deprecated,
+		); err != nil {
+			return err
+		}
+		if len(v.Constraints) > 0 {
+			ex.issue("scalar "+v.Name, "constraints can't be represented")
+		}
+		ex.printf("scalar %s\n\n", name)
+	}
+	return nil
+}
+
+// exportEnumTypes exports all enum types
+func (ex *exporter) exportEnumTypes() error {
+	for _, t := range ex.mod.EnumTypes {
+		v := t.(*parser.TypeEnum)
+		name := generator.TypeName(v)
+		if err := ex.declare(
+			name,
+			"enum "+v.Name,
+			v.Doc,
+			v.Deprecated,
+		); err != nil {
+			return err
+		}
+		ex.printf("enum %s {\n", name)
+		for _, val := range v.Values {
+			ex.writeDescription("\t", val.Doc)
+			ex.printf("\t%s\n", val.Name)
+		}
+		ex.printf("}\n\n")
+	}
+	return nil
+}
+
+// exportNoneAliases exports objects for all aliases of None
+// since GraphQL has no unit type.
+// The objects have a single placeholder field
+// because GraphQL objects must define at least one field
+func (ex *exporter) exportNoneAliases() error {
+	for _, t := range ex.mod.AliasTypes {
+		if !isNoneAlias(t) {
+			continue
+		}
+		v := t.(*parser.TypeAlias)
+		name := generator.TypeName(v)
+		if err := ex.declare(
+			name,
+			"alias "+v.Name,
+			v.Doc,
+			v.Deprecated,
+		); err != nil {
+			return err
+		}
+		ex.printf("type %s {\n\t_: Boolean\n}\n\n", name)
+	}
+	return nil
+}
+
+// exportTraitTypes exports interfaces for all trait types
+func (ex *exporter) exportTraitTypes() error {
+	for _, t := range ex.mod.TraitTypes {
+		v := t.(*parser.TypeTrait)
+		name := generator.TypeName(v)
+		if err := ex.declare(
+			name,
+			"trait "+v.Name,
+			v.Doc,
+			v.Deprecated,
+		); err != nil {
+			return err
+		}
+		ex.printf("interface %s {\n", name)
+		for _, prop := range v.Properties {
+			subject := "trait property " + prop.GraphNodeName()
+			ex.writeDescription("\t", prop.Doc)
+			ex.printf(
+				"\t%s%s: %s%s\n",
+				prop.Name,
+				ex.args(prop.Parameters),
+				ex.ref(prop.Type, false, subject),
+				deprecated(prop.Deprecated),
+			)
+		}
+		ex.printf("}\n\n")
+	}
+	return nil
+}
+
+// exportStructTypes exports objects for all struct types used as outputs
+// and input objects for all struct types used as inputs
+func (ex *exporter) exportStructTypes() error {
+	for _, t := range ex.mod.StructTypes {
+		v := t.(*parser.TypeStruct)
+		name := generator.TypeName(v)
+
+		// Export unused structs as objects
+		asObject := ex.outputs[v] || !ex.inputs[v]
+		if asObject {
+			if err := ex.declare(
+				name,
+				"struct "+v.Name,
+				v.Doc,
+				v.Deprecated,
+			); err != nil {
+				return err
+			}
+			ex.printf("type %s%s {\n", name, implements(v.Implements))
+			ex.exportStructFields(v, false)
+			ex.printf("}\n\n")
+		}
+
+		if ex.inputs[v] {
+			// Report deprecations only once per struct
+			deprecation := v.Deprecated
+			if asObject {
+				deprecation = nil
+			}
+			if err := ex.declare(
+				ex.inputName(v),
+				"struct "+v.Name,
+				v.Doc,
+				deprecation,
+			); err != nil {
+				return err
+			}
+			ex.printf("input %s {\n", ex.inputName(v))
+			ex.exportStructFields(v, true)
+			ex.printf("}\n\n")
+		}
+	}
+	return nil
+}
+
+// exportStructFields exports the fields of either an object
+// or an input object
+func (ex *exporter) exportStructFields(t *parser.TypeStruct, input bool) {
+	for _, fld := range t.Fields {
+		subject := "struct field " + fld.GraphNodeName()
+		if input && len(fld.Constraints) > 0 {
+			ex.issue(subject, "constraints can't be represented")
+		}
+		ex.writeDescription("\t", fld.Doc)
+		directives := deprecated(fld.Deprecated)
+		if input {
+			// Input fields can't be deprecated in GraphQL
+			directives = ""
+		}
+		ex.printf(
+			"\t%s: %s%s\n",
+			fld.Name,
+			ex.ref(fld.Type, input, subject),
+			directives,
+		)
+	}
+}
+
+// exportResolverTypes exports objects for all resolver types
+func (ex *exporter) exportResolverTypes() error {
+	for _, t := range ex.mod.ResolverTypes {
+		v := t.(*parser.TypeResolver)
+		name := generator.TypeName(v)
+		if err := ex.declare(
+			name,
+			"resolver "+v.Name,
+			v.Doc,
+			v.Deprecated,
+		); err != nil {
+			return err
+		}
+		ex.printf("type %s%s {\n", name, implements(v.Implements))
+		for _, prop := range v.Properties {
+			subject := "resolver property " + prop.GraphNodeName()
+			ex.writeDescription("\t", prop.Doc)
+			ex.printf(
+				"\t%s%s: %s%s\n",
+				prop.Name,
+				ex.args(prop.Parameters),
+				ex.ref(prop.Type, false, subject),
+				deprecated(prop.Deprecated),
+			)
+		}
+		ex.printf("}\n\n")
+	}
+	return nil
+}
+
+// exportUnionTypes exports all union types.
+// Option types that aren't objects are wrapped by objects
+// holding the option value in their value field
+func (ex *exporter) exportUnionTypes() error {
+	for _, t := range ex.mod.UnionTypes {
+		v := t.(*parser.TypeUnion)
+		name := generator.TypeName(v)
+		if err := ex.declare(
+			name,
+			"union "+v.Name,
+			v.Doc,
+			v.Deprecated,
+		); err != nil {
+			return err
+		}
+		members := make([]string, len(v.Types))
+		for i, opt := range v.Types {
+			members[i] = ex.member(v, opt)
+		}
+		ex.printf("union %s = %s\n\n", name, strings.Join(members, " | "))
+	}
+
+	for _, name := range ex.order {
+		opt := ex.wrappers[name]
+		if err := ex.idents.Declare(
+			name,
+			"wrapper of union option "+opt.String(),
+		); err != nil {
+			return err
+		}
+		ex.printf(
+			"type %s {\n\tvalue: %s\n}\n\n",
+			name,
+			ex.ref(opt, false, "union option "+opt.String()),
+		)
+	}
+	return nil
+}
+
+// member returns the name of the object representing
+// the given option of a union
+func (ex *exporter) member(union *parser.TypeUnion, opt parser.Type) string {
+	// Follow the aliases to the object type if there's any
+	for t := opt; ; {
+		if isNoneAlias(t) {
+			return generator.TypeName(t)
+		}
+		alias, isAlias := t.(*parser.TypeAlias)
+		if !isAlias {
+			switch v := t.(type) {
+			case *parser.TypeResolver, *parser.TypeStruct:
+				return generator.TypeName(v)
+			}
+			break
+		}
+		t = alias.AliasedType
+	}
+
+	// Wrap options that aren't objects. Wrappers of aliases
+	// are named after the alias since aliases are otherwise inlined
+	name := generator.TypeName(union) + generator.TypeName(opt)
+	if _, isAlias := opt.(*parser.TypeAlias); isAlias {
+		name = generator.TypeName(opt)
+	}
+	if _, isWrapped := ex.wrappers[name]; !isWrapped {
+		ex.wrappers[name] = opt
+		ex.order = append(ex.order, name)
+	}
+	return name
+}
+
+// exportRoots exports the root types of the query endpoints,
+// mutations and subscriptions
+func (ex *exporter) exportRoots() error {
+	type endpoint struct {
+		subject     string
+		name        string
+		params      []*parser.Parameter
+		result      parser.Type
+		doc         string
+		deprecation *parser.Deprecation
+	}
+	export := func(root string, endpoints []endpoint) error {
+		if len(endpoints) < 1 {
+			return nil
+		}
+		if err := ex.idents.Declare(root, "the root type "+root); err != nil {
+			return err
+		}
+		ex.printf("type %s {\n", root)
+		for _, e := range endpoints {
+			ex.writeDescription("\t", e.doc)
+			ex.printf(
+				"\t%s%s: %s%s\n",
+				e.name,
+				ex.args(e.params),
+				ex.ref(e.result, false, e.subject),
+				deprecated(e.deprecation),
+			)
+		}
+		ex.printf("}\n\n")
+		return nil
+	}
+
+	queries := make([]endpoint, len(ex.mod.QueryEndpoints))
+	for i, q := range ex.mod.QueryEndpoints {
+		queries[i] = endpoint{
+			"query " + q.Name,
+			q.Name,
+			q.Parameters,
+			q.Type,
+			q.Doc,
+			q.Deprecated,
+		}
+	}
+	mutations := make([]endpoint, len(ex.mod.Mutations))
+	for i, m := range ex.mod.Mutations {
+		mutations[i] = endpoint{
+			"mutation " + m.Name,
+			m.Name,
+			m.Parameters,
+			m.Type,
+			m.Doc,
+			m.Deprecated,
+		}
+	}
+	subscriptions := make([]endpoint, len(ex.mod.Subscriptions))
+	for i, s := range ex.mod.Subscriptions {
+		subscriptions[i] = endpoint{
+			"subscription " + s.Name,
+			s.Name,
+			s.Parameters,
+			s.Type,
+			s.Doc,
+			s.Deprecated,
+		}
+	}
+
+	if err := export("Query", queries); err != nil {
+		return err
+	}
+	if err := export("Mutation", mutations); err != nil {
+		return err
+	}
+	return export("Subscription", subscriptions)
+}
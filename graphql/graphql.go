@@ -0,0 +1,172 @@
+// Package graphql converts schema models to and from GraphQL SDL
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// Issue describes a construct that can't be represented
+// in the target schema language
+type Issue struct {
+	// Subject names the affected declaration
+	Subject string
+
+	Message string
+}
+
+// String stringifies the issue
+func (i Issue) String() string {
+	return i.Subject + ": " + i.Message
+}
+
+// exporter converts a schema model to GraphQL SDL
+type exporter struct {
+	mod    *parser.SchemaModel
+	out    strings.Builder
+	issues []Issue
+	idents generator.Identifiers
+
+	// inputs lists the struct types used as inputs
+	inputs map[*parser.TypeStruct]bool
+
+	// outputs lists the struct types used as outputs
+	outputs map[*parser.TypeStruct]bool
+
+	// scalars lists the custom scalars referenced by the exported schema
+	scalars map[string]bool
+
+	// wrappers lists the wrapper objects of union options
+	// which aren't object types by name
+	wrappers map[string]parser.Type
+	order    []string
+}
+
+// Export converts the given schema model to GraphQL SDL returning
+// the issues encountered on constructs that can't be represented
+// in GraphQL. Returns an error if the exported names collide
+func Export(mod *parser.SchemaModel) ([]byte, []Issue, error) {
+	ex := &exporter{
+		mod:      mod,
+		idents:   make(generator.Identifiers),
+		inputs:   make(map[*parser.TypeStruct]bool),
+		outputs:  make(map[*parser.TypeStruct]bool),
+		scalars:  make(map[string]bool),
+		wrappers: make(map[string]parser.Type),
+	}
+	ex.findStructUsage()
+
+	// Write the declarations into a separate buffer first
+	// since the referenced scalars are only known afterwards
+	for _, export := range []func() error{
+		ex.exportScalarTypes,
+		ex.exportEnumTypes,
+		ex.exportNoneAliases,
+		ex.exportTraitTypes,
+		ex.exportStructTypes,
+		ex.exportResolverTypes,
+		ex.exportUnionTypes,
+		ex.exportRoots,
+	} {
+		if err := export(); err != nil {
+			return nil, nil, err
+		}
+	}
+	body := ex.out.String()
+	ex.out.Reset()
+
+	// Declare the primitives not built into GraphQL
+	// and the map types as custom scalars
+	scalars := make([]string, 0, len(ex.scalars))
+	for name := range ex.scalars {
+		scalars = append(scalars, name)
+	}
+	sort.Strings(scalars)
+	for _, name := range scalars {
+		if err := ex.idents.Declare(name, "scalar "+name); err != nil {
+			return nil, nil, err
+		}
+		ex.printf("scalar %s\n\n", name)
+	}
+	ex.out.WriteString(body)
+
+	return []byte(strings.TrimRight(ex.out.String(), "\n") + "\n"),
+		ex.issues,
+		nil
+}
+
+// printf writes formatted SDL
+func (ex *exporter) printf(format string, v ...interface{}) {
+	fmt.Fprintf(&ex.out, format, v...)
+}
+
+// issue records a construct that can't be represented
+// unless it's already recorded
+func (ex *exporter) issue(subject, format string, v ...interface{}) {
+	newIssue := Issue{
+		Subject: subject,
+		Message: fmt.Sprintf(format, v...),
+	}
+	for _, issue := range ex.issues {
+		if issue == newIssue {
+			return
+		}
+	}
+	ex.issues = append(ex.issues, newIssue)
+}
+
+// writeDescription writes the description of a declaration
+func (ex *exporter) writeDescription(indent, doc string) {
+	if doc == "" {
+		return
+	}
+	if !strings.Contains(doc, "\n") {
+		ex.printf("%s%s\n", indent, quote(doc))
+		return
+	}
+	ex.printf("%s\"\"\"\n", indent)
+	for _, line := range strings.Split(doc, "\n") {
+		ex.printf("%s%s\n", indent, line)
+	}
+	ex.printf("%s\"\"\"\n", indent)
+}
+
+// deprecated returns the deprecation directive of a declaration
+func deprecated(d *parser.Deprecation) string {
+	if d == nil {
+		return ""
+	}
+	reason := d.Reason
+	if d.Replacement != "" {
+		reason += " (use " + d.Replacement + " instead)"
+	}
+	return " @deprecated(reason: " + quote(reason) + ")"
+}
+
+// quote returns the GraphQL string literal of the given string
+func quote(s string) string {
+	b := strings.Builder{}
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
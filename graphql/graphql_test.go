@@ -0,0 +1,228 @@
+package graphql_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/romshark/gapi/graphql"
+	"github.com/romshark/gapi/internal/gapitest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExport tests exporting GraphQL SDL
+func TestExport(t *testing.T) {
+	sdl, issues, err := graphql.Export(gapitest.Compile(t, `schema test
+	alias ErrNotFound = None
+	alias ErrInvalid = String
+	scalar Cents Int64 where min(0)
+	enum Color { red green }
+
+	# Named is anything named
+	trait Named {
+		name String
+	}
+
+	struct Filter {
+		color ?Color
+		limit Uint32
+	}
+
+	struct Item implements Named {
+		name String
+		color Color
+		prices [String]Cents
+		added Time
+	}
+
+	union Result {
+		Item
+		ErrNotFound
+		ErrInvalid
+		[]String
+	}
+
+	resolver Shop implements Named {
+		name String
+		items(
+			filter Filter = {color: red, limit: 10},
+		) []Item
+	}
+
+	query shop(id UUID) ?Shop
+	deprecated("use shop")
+	query items []Item
+	mutation add(item Item) Result`))
+	require.NoError(t, err)
+
+	require.Equal(t, `scalar MapStringCents
+
+scalar Time
+
+scalar UUID
+
+scalar Uint32
+
+scalar Cents
+
+enum Color {
+	red
+	green
+}
+
+type ErrNotFound {
+	_: Boolean
+}
+
+"Named is anything named"
+interface Named {
+	name: String!
+}
+
+input Filter {
+	color: Color
+	limit: Uint32!
+}
+
+type Item implements Named {
+	name: String!
+	color: Color!
+	prices: MapStringCents!
+	added: Time!
+}
+
+input ItemInput {
+	name: String!
+	color: Color!
+	prices: MapStringCents!
+	added: Time!
+}
+
+type Shop implements Named {
+	name: String!
+	items(filter: Filter! = {color: red, limit: 10}): [Item!]!
+}
+
+union Result = Item | ErrNotFound | ErrInvalid | ResultListString
+
+type ErrInvalid {
+	value: String!
+}
+
+type ResultListString {
+	value: [String!]!
+}
+
+type Query {
+	items: [Item!]! @deprecated(reason: "use shop")
+	shop(id: UUID!): Shop
+}
+
+type Mutation {
+	add(item: ItemInput!): Result!
+}
+`, string(sdl))
+
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.String()
+	}
+	require.Equal(t, []string{
+		"scalar Cents: constraints can't be represented",
+		"struct field Item.prices: map type [String]Cents is represented " +
+			"by the custom scalar MapStringCents",
+	}, messages)
+}
+
+// TestExportIssues tests reporting constructs GraphQL can't represent
+func TestExportIssues(t *testing.T) {
+	_, issues, err := graphql.Export(gapitest.Compile(t, `schema test
+	alias ErrNotFound = None
+	union U { Int32 String }
+	deprecated("replaced")
+	struct S {
+		u U
+		l []?U
+	}
+	query q(s S, n Int32 where max(5)) ?ErrNotFound`))
+	require.NoError(t, err)
+
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.String()
+	}
+	require.Equal(t, []string{
+		"struct S: types can't be deprecated",
+		"struct field S.u: union U can't be used as input " +
+			"and is represented by the custom scalar UInput",
+		"struct field S.l: union U can't be used as input " +
+			"and is represented by the custom scalar UInput",
+		"parameter q.n: constraints can't be represented",
+	}, messages)
+}
+
+// TestExportAbstractInputs tests exporting unions and traits
+// used as inputs
+func TestExportAbstractInputs(t *testing.T) {
+	sdl, issues, err := graphql.Export(gapitest.Compile(t, `schema test
+	union U { S E }
+	trait N { s String }
+	struct S implements N { s String }
+	struct E { e Int32 }
+	query q(u U, n ?N) Bool`))
+	require.NoError(t, err)
+	require.Equal(t, "scalar NInput\n"+
+		"\n"+
+		"scalar UInput\n"+
+		"\n"+
+		"interface N {\n"+
+		"\ts: String!\n"+
+		"}\n"+
+		"\n"+
+		"type E {\n"+
+		"\te: Int!\n"+
+		"}\n"+
+		"\n"+
+		"type S implements N {\n"+
+		"\ts: String!\n"+
+		"}\n"+
+		"\n"+
+		"union U = S | E\n"+
+		"\n"+
+		"type Query {\n"+
+		"\tq(u: UInput!, n: NInput): Boolean!\n"+
+		"}\n",
+		string(sdl),
+	)
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.String()
+	}
+	require.Equal(t, []string{
+		"parameter q.u: union U can't be used as input " +
+			"and is represented by the custom scalar UInput",
+		"parameter q.n: trait N can't be used as input " +
+			"and is represented by the custom scalar NInput",
+	}, messages)
+}
+
+// TestExportExample tests exporting the example schema
+func TestExportExample(t *testing.T) {
+	src, err := ioutil.ReadFile("../example/filesystem.gapi")
+	require.NoError(t, err)
+	sdl, _, err := graphql.Export(gapitest.Compile(t, string(src)))
+	require.NoError(t, err)
+	require.Contains(t, string(sdl), "type User {")
+	require.Contains(
+		t,
+		string(sdl),
+		"union QrCollection = Collection | ErrUnauth",
+	)
+}
+
+// TestExportErrs tests export errors
+func TestExportErrs(t *testing.T) {
+	_, _, err := graphql.Export(gapitest.Compile(t, `schema test
+	struct Query { s String }
+	query q Query`))
+	require.Error(t, err)
+}
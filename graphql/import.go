@@ -0,0 +1,404 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/romshark/gapi/compiler"
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// defaultDeprecationReason is the reason of deprecations
+// that don't specify one
+const defaultDeprecationReason = "No longer supported"
+
+// primitives lists the names of the GAPI primitive types
+var primitives = []string{
+	"None",
+	"Bool",
+	"Byte",
+	"Int8",
+	"Int16",
+	"Int32",
+	"Int64",
+	"Uint8",
+	"Uint16",
+	"Uint32",
+	"Uint64",
+	"Float32",
+	"Float64",
+	"String",
+	"Time",
+	"Date",
+	"Duration",
+	"UUID",
+	"Decimal",
+}
+
+// builtinScalars maps the built-in GraphQL scalars
+// to the GAPI types representing them
+var builtinScalars = map[string]string{
+	"Int":     "Int32",
+	"Float":   "Float64",
+	"String":  "String",
+	"Boolean": "Bool",
+	"ID":      "ID",
+}
+
+// importer converts GraphQL SDL definitions to GAPI schema source
+type importer struct {
+	out    strings.Builder
+	issues []Issue
+	idents generator.Identifiers
+	defs   []*sdlDefinition
+
+	// types maps the names of the defined GraphQL types
+	// to their definitions
+	types map[string]*sdlDefinition
+
+	// names maps the names of the defined GraphQL types
+	// to the names of the GAPI types representing them
+	names map[string]string
+
+	// roots maps the names of the root types to their operation types
+	roots map[string]string
+
+	// usesID is true if the built-in ID scalar is referenced
+	usesID bool
+}
+
+// Convert converts the given GraphQL SDL to the source of a GAPI schema
+// of the given name returning the issues encountered on constructs
+// that can't be represented in GAPI. Returns an error if the SDL
+// is malformed or can't be converted
+func Convert(schemaName string, sdl string) ([]byte, []Issue, error) {
+	defs, err := parseSDL(sdl)
+	if err != nil {
+		return nil, nil, err
+	}
+	im := &importer{
+		idents: make(generator.Identifiers),
+		types:  make(map[string]*sdlDefinition),
+		names:  make(map[string]string),
+		roots:  make(map[string]string),
+	}
+	for _, name := range primitives {
+		if err := im.idents.Declare(name, "primitive "+name); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := im.collect(defs); err != nil {
+		return nil, nil, err
+	}
+	if err := im.nameTypes(); err != nil {
+		return nil, nil, err
+	}
+
+	// Write the declarations into a separate buffer first
+	// since the usage of the ID scalar is only known afterwards
+	for _, def := range im.defs {
+		var err error
+		switch def.Keyword {
+		case "scalar":
+			im.convertScalar(def)
+		case "enum":
+			err = im.convertEnum(def)
+		case "input":
+			err = im.convertInput(def)
+		case "interface":
+			err = im.convertInterface(def)
+		case "type":
+			if operation, isRoot := im.roots[def.Name]; isRoot {
+				err = im.convertRoot(def, operation)
+				break
+			}
+			err = im.convertObject(def)
+		case "union":
+			err = im.convertUnion(def)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	body := im.out.String()
+	im.out.Reset()
+
+	im.printf("schema %s\n\n", schemaName)
+	if im.usesID {
+		if err := im.idents.Declare("ID", "scalar ID"); err != nil {
+			return nil, nil, err
+		}
+		im.printf("# ID is a unique identifier\nalias ID = String\n\n")
+	}
+	im.out.WriteString(body)
+
+	return []byte(strings.TrimRight(im.out.String(), "\n") + "\n"),
+		im.issues,
+		nil
+}
+
+// Import converts the given GraphQL SDL to a GAPI schema of the given name
+// returning the compiled schema model, the schema source it was compiled
+// from and the issues encountered on constructs that can't be represented
+// in GAPI. Returns an error if the SDL can't be converted or if the
+// converted schema doesn't compile
+func Import(schemaName string, sdl string) (
+	*parser.SchemaModel,
+	[]byte,
+	[]Issue,
+	error,
+) {
+	src, issues, err := Convert(schemaName, sdl)
+	if err != nil {
+		return nil, nil, issues, err
+	}
+	mod, _, err := compiler.Compile(parser.SourceFile{
+		File: parser.File{Name: schemaName + ".gapi"},
+		Src:  string(src),
+	})
+	if err != nil {
+		return nil, src, issues, fmt.Errorf(
+			"compiling the converted schema: %s",
+			err,
+		)
+	}
+	return mod, src, issues, nil
+}
+
+// printf writes formatted GAPI source
+func (im *importer) printf(format string, v ...interface{}) {
+	fmt.Fprintf(&im.out, format, v...)
+}
+
+// issue records a construct that can't be represented
+func (im *importer) issue(subject, format string, v ...interface{}) {
+	im.issues = append(im.issues, Issue{
+		Subject: subject,
+		Message: fmt.Sprintf(format, v...),
+	})
+}
+
+// collect collects the type definitions and the root types
+// reporting the definitions that can't be converted
+func (im *importer) collect(defs []*sdlDefinition) error {
+	var schema *sdlDefinition
+	for _, def := range defs {
+		subject := def.Keyword + " " + def.Name
+		switch {
+		case def.Extension:
+			im.issue(
+				"extend "+strings.TrimSuffix(subject, " "),
+				"type system extensions aren't supported",
+			)
+			continue
+		case def.Keyword == "directive":
+			im.issue(
+				"directive @"+def.Name,
+				"directive definitions aren't supported",
+			)
+			continue
+		case def.Keyword == "schema":
+			if schema != nil {
+				return fmt.Errorf(
+					"redefined schema definition at line %d",
+					def.Line,
+				)
+			}
+			schema = def
+			im.unsupportedDirectives("schema", def.Directives)
+			continue
+		}
+		if _, isDefined := im.types[def.Name]; isDefined {
+			return fmt.Errorf(
+				"redefined type %s at line %d",
+				def.Name,
+				def.Line,
+			)
+		}
+		im.types[def.Name] = def
+		im.defs = append(im.defs, def)
+	}
+
+	// Determine the root types
+	if schema == nil {
+		schema = &sdlDefinition{Operations: map[string]string{
+			"query":        "Query",
+			"mutation":     "Mutation",
+			"subscription": "Subscription",
+		}}
+	}
+	for _, operation := range []string{"query", "mutation", "subscription"} {
+		name, isDefined := schema.Operations[operation]
+		if !isDefined {
+			continue
+		}
+		if def, isDefined := im.types[name]; isDefined {
+			if def.Keyword != "type" {
+				return fmt.Errorf(
+					"%s root type %s isn't an object type",
+					operation,
+					name,
+				)
+			}
+			im.roots[name] = operation
+		} else if schema.Line > 0 {
+			return fmt.Errorf(
+				"undefined %s root type %s",
+				operation,
+				name,
+			)
+		}
+	}
+	for operation := range schema.Operations {
+		switch operation {
+		case "query", "mutation", "subscription":
+		default:
+			return fmt.Errorf("unknown operation type %s", operation)
+		}
+	}
+	return nil
+}
+
+// nameTypes determines the names of the GAPI types
+// representing the defined GraphQL types
+func (im *importer) nameTypes() error {
+	for _, def := range im.defs {
+		if _, isRoot := im.roots[def.Name]; isRoot {
+			continue
+		}
+		subject := def.Keyword + " " + def.Name
+		if def.Keyword == "scalar" {
+			if _, isBuiltin := builtinScalars[def.Name]; isBuiltin {
+				// Built-in scalars don't need to be declared
+				continue
+			}
+			if isPrimitive(def.Name) {
+				im.issue(subject, "represented by the primitive %s", def.Name)
+				im.names[def.Name] = def.Name
+				continue
+			}
+		}
+		name, err := convertName(def.Name, true)
+		if err != nil {
+			return fmt.Errorf("%s: %s", subject, err)
+		}
+		if name != def.Name {
+			im.issue(subject, "renamed to %s", name)
+		}
+		if err := im.idents.Declare(name, subject); err != nil {
+			return err
+		}
+		im.names[def.Name] = name
+	}
+	return nil
+}
+
+// isPrimitive returns true if the given name is the name
+// of a GAPI primitive type
+func isPrimitive(name string) bool {
+	for _, primitive := range primitives {
+		if primitive == name {
+			return true
+		}
+	}
+	return false
+}
+
+// convertName converts a GraphQL name to a GAPI identifier
+// which is either capitalized or lower camel case
+// keeping names that are already valid
+func convertName(name string, capitalized bool) (string, error) {
+	if isIdentifier(name, capitalized) {
+		return name, nil
+	}
+
+	// Join the words separated by underscores lowering
+	// the case of upper case words such as in SOME_VALUE
+	b := strings.Builder{}
+	for _, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+		if strings.ToUpper(word) == word {
+			word = strings.ToLower(word)
+		}
+		if b.Len() < 1 && !capitalized {
+			word = lowerInitial(word)
+		} else {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		b.WriteString(word)
+	}
+	converted := b.String()
+	if !isIdentifier(converted, capitalized) {
+		return "", fmt.Errorf("can't convert name %s", name)
+	}
+	return converted, nil
+}
+
+// lowerInitial lowers the case of the leading upper case letters
+// of the given word except for the last one preceding lower case
+// letters such that URLPath becomes urlPath
+func lowerInitial(word string) string {
+	n := 0
+	for n < len(word) && word[n] >= 'A' && word[n] <= 'Z' {
+		n++
+	}
+	if n > 1 && n < len(word) {
+		n--
+	}
+	return strings.ToLower(word[:n]) + word[n:]
+}
+
+// isIdentifier returns true if the given name is a valid GAPI identifier
+func isIdentifier(name string, capitalized bool) bool {
+	if name == "" {
+		return false
+	}
+	if capitalized && (name[0] < 'A' || name[0] > 'Z') {
+		return false
+	}
+	if !capitalized && (name[0] < 'a' || name[0] > 'z') {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isLetter(name[i]) && !isDigit(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// memberNames converts the names of the members of a type
+// such as fields and enum values reporting renamed members.
+// Returns an error if converted names collide
+func (im *importer) memberNames(
+	subject string,
+	names []string,
+	capitalized bool,
+) ([]string, error) {
+	converted := make([]string, len(names))
+	taken := make(map[string]string, len(names))
+	for i, name := range names {
+		c, err := convertName(name, capitalized)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %s", subject, name, err)
+		}
+		if other, isTaken := taken[c]; isTaken {
+			return nil, fmt.Errorf(
+				"%s: both %s and %s are converted to %s",
+				subject,
+				other,
+				name,
+				c,
+			)
+		}
+		if c != name {
+			im.issue(subject+"."+name, "renamed to %s", c)
+		}
+		taken[c] = name
+		converted[i] = c
+	}
+	return converted, nil
+}
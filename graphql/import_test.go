@@ -0,0 +1,245 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/romshark/gapi/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImport tests converting GraphQL SDL to a schema model
+func TestImport(t *testing.T) {
+	mod, src, issues, err := graphql.Import("shop", `
+	schema {
+		query: RootQuery
+		mutation: RootMutation
+	}
+
+	scalar DateTime
+	scalar UUID @specifiedBy(url: "https://tools.ietf.org/html/rfc4122")
+
+	directive @auth(role: String) on FIELD_DEFINITION
+
+	"Color of an item"
+	enum Color {
+		"Pure red"
+		RED
+		DARK_BLUE @deprecated
+	}
+
+	interface Named {
+		name: String!
+	}
+
+	interface Priced {
+		price(currency: String = "EUR"): Float!
+	}
+
+	type Item implements Named & Priced {
+		id: ID!
+		name: String!
+		price(currency: String = "EUR", round: Boolean): Float!
+		color: Color
+		tags: [String]!
+		added_at: DateTime! @deprecated(reason: "use created")
+		created: DateTime @auth(role: "admin")
+	}
+
+	type ErrNotFound {
+		_: Boolean
+	}
+
+	union ItemResult = Item | ErrNotFound
+	union Single = Item
+
+	input ItemFilter {
+		color: Color = RED
+		"""
+		Maximum number of items
+		"""
+		limit: Int!
+		ids: [UUID!]
+	}
+
+	type RootQuery {
+		"items returns all items matching the filter"
+		items(filter: ItemFilter = {color: DARK_BLUE, limit: 10}): [Item!]!
+		item(id: ID!): ItemResult!
+		single: Single
+	}
+
+	type RootMutation {
+		addItem(
+			"name of the new item"
+			name: String!
+			color: Color!
+		): Item
+	}
+
+	extend type Item {
+		extra: Int
+	}`)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+	require.Len(t, mod.ResolverTypes, 1)
+	require.Len(t, mod.StructTypes, 1)
+	require.Len(t, mod.TraitTypes, 2)
+	require.Len(t, mod.QueryEndpoints, 3)
+	require.Len(t, mod.Mutations, 1)
+
+	require.Equal(t, `schema shop
+
+# ID is a unique identifier
+alias ID = String
+
+alias DateTime = String
+
+# Color of an item
+enum Color {
+	# Pure red
+	red
+	darkBlue
+}
+
+trait Named {
+	name String
+}
+
+trait Priced {
+	price(currency ?String = "EUR") Float64
+}
+
+resolver Item implements Named {
+	id ID
+	name String
+	price(
+		currency ?String = "EUR",
+		round ?Bool,
+	) Float64
+	color ?Color
+	tags []?String
+	deprecated("use created")
+	addedAt DateTime
+	created ?DateTime
+}
+
+alias ErrNotFound = None
+
+union ItemResult {
+	Item
+	ErrNotFound
+}
+
+alias Single = Item
+
+struct ItemFilter {
+	color ?Color
+	# Maximum number of items
+	limit Int32
+	ids ?[]UUID
+}
+
+# items returns all items matching the filter
+query items(filter ?ItemFilter = {color: darkBlue, limit: 10}) []Item
+
+query item(id ID) ItemResult
+
+query single ?Single
+
+mutation addItem(
+	# name of the new item
+	name String,
+	color Color,
+) ?Item
+`, string(src))
+
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.String()
+	}
+	require.Equal(t, []string{
+		"directive @auth: directive definitions aren't supported",
+		"extend type Item: type system extensions aren't supported",
+		"scalar UUID: represented by the primitive UUID",
+		"scalar UUID: directive @specifiedBy isn't supported",
+		"enum Color.RED: renamed to red",
+		"enum Color.DARK_BLUE: renamed to darkBlue",
+		"enum Color.DARK_BLUE: deprecation isn't supported",
+		"type Item: interface Priced isn't implemented because " +
+			"the signature of field price differs " +
+			"while GAPI requires identical signatures",
+		"type Item.added_at: renamed to addedAt",
+		"type Item.created: directive @auth isn't supported",
+		"type ErrNotFound: converted to an alias of None",
+		"union Single: converted to an alias of its only member",
+		"input ItemFilter.color: default values of fields aren't supported",
+	}, messages)
+}
+
+// TestImportErrs tests SDL conversion errors
+func TestImportErrs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		SDL string
+		Err string
+	}{
+		"UnterminatedString": {
+			SDL: `type Query { "a: String }`,
+			Err: "syntax error at 1:14: unterminated string",
+		},
+		"MissingType": {
+			SDL: `type Query { a }`,
+			Err: "syntax error at 1:16: unexpected '}', expected ':'",
+		},
+		"UnexpectedDefinition": {
+			SDL: `query { a }`,
+			Err: `syntax error at 1:1: unexpected "query", ` +
+				`expected type system definition`,
+		},
+		"UndefinedType": {
+			SDL: `type Query { a: Foo }`,
+			Err: "type Query.a: undefined type Foo",
+		},
+		"RedefinedType": {
+			SDL: `enum A { x } enum A { y } type Query { a: A }`,
+			Err: "redefined type A at line 1",
+		},
+		"UndefinedRootType": {
+			SDL: `schema { query: Root }`,
+			Err: "undefined query root type Root",
+		},
+		"ReferencedRootType": {
+			SDL: `type Query { q: Query }`,
+			Err: "type Query.q: root type Query can't be referenced",
+		},
+		"CollidingFieldNames": {
+			SDL: `type Query { a_b: Int a__b: Int }`,
+			Err: "type Query: both a_b and a__b are converted to aB",
+		},
+		"CollidingTypeNames": {
+			SDL: `enum a_b { x } enum AB { y } type Query { a: AB }`,
+			Err: "identifier AB of enum AB collides with enum a_b",
+		},
+		"CollidingPrimitiveName": {
+			SDL: `enum Time { x } type Query { a: Time }`,
+			Err: "identifier Time of enum Time collides with primitive Time",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			src, _, err := graphql.Convert("test", tc.SDL)
+			require.Error(t, err)
+			require.Nil(t, src)
+			require.Equal(t, tc.Err, err.Error())
+		})
+	}
+}
+
+// TestImportCompilationErr tests importing SDL
+// which converts to a schema that doesn't compile
+func TestImportCompilationErr(t *testing.T) {
+	mod, src, _, err := graphql.Import("test", `
+	type A
+	type Query { a: A }`)
+	require.Error(t, err)
+	require.Nil(t, mod)
+	require.Contains(t, string(src), "resolver A {\n}")
+}
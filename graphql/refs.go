@@ -0,0 +1,208 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// findStructUsage determines which struct types are used
+// as inputs and which as outputs. Union members are always outputs
+// since unions can only consist of object types
+func (ex *exporter) findStructUsage() {
+	var mark func(t parser.Type, set map[*parser.TypeStruct]bool)
+	mark = func(t parser.Type, set map[*parser.TypeStruct]bool) {
+		switch v := t.(type) {
+		case *parser.TypeAlias:
+			mark(generator.Unaliased(v), set)
+		case *parser.TypeOptional:
+			mark(v.StoreType, set)
+		case *parser.TypeList:
+			mark(v.StoreType, set)
+		case *parser.TypeMap:
+			mark(v.StoreType, set)
+		case *parser.TypeUnion:
+			for _, opt := range v.Types {
+				mark(opt, ex.outputs)
+			}
+		case *parser.TypeStruct:
+			if set[v] {
+				return
+			}
+			set[v] = true
+			for _, fld := range v.Fields {
+				mark(fld.Type, set)
+			}
+		}
+	}
+	markParams := func(params []*parser.Parameter) {
+		for _, param := range params {
+			mark(param.Type, ex.inputs)
+		}
+	}
+
+	for _, t := range ex.mod.ResolverTypes {
+		for _, prop := range t.(*parser.TypeResolver).Properties {
+			mark(prop.Type, ex.outputs)
+			markParams(prop.Parameters)
+		}
+	}
+	for _, t := range ex.mod.TraitTypes {
+		for _, prop := range t.(*parser.TypeTrait).Properties {
+			mark(prop.Type, ex.outputs)
+			markParams(prop.Parameters)
+		}
+	}
+	for _, q := range ex.mod.QueryEndpoints {
+		mark(q.Type, ex.outputs)
+		markParams(q.Parameters)
+	}
+	for _, m := range ex.mod.Mutations {
+		mark(m.Type, ex.outputs)
+		markParams(m.Parameters)
+	}
+	for _, s := range ex.mod.Subscriptions {
+		mark(s.Type, ex.outputs)
+		markParams(s.Parameters)
+	}
+}
+
+// inputName returns the name of the input object of the given struct
+func (ex *exporter) inputName(t *parser.TypeStruct) string {
+	if ex.outputs[t] {
+		return generator.TypeName(t) + "Input"
+	}
+	return generator.TypeName(t)
+}
+
+// ref returns the GraphQL type reference of the given type.
+// Types are non-null unless they're optional
+func (ex *exporter) ref(t parser.Type, input bool, subject string) string {
+	switch v := t.(type) {
+	case *parser.TypeOptional:
+		return strings.TrimSuffix(ex.ref(v.StoreType, input, subject), "!")
+	case *parser.TypeAlias:
+		if isNoneAlias(v) {
+			if input {
+				ex.issue(subject, "error type %s can't be used as input", v)
+			}
+			return generator.TypeName(v) + "!"
+		}
+		// Aliases don't exist in GraphQL and are replaced by their types
+		return ex.ref(v.AliasedType, input, subject)
+	case *parser.TypeList:
+		return "[" + ex.ref(v.StoreType, input, subject) + "]!"
+	case *parser.TypeMap:
+		name := generator.TypeName(v)
+		ex.issue(
+			subject,
+			"map type %s is represented by the custom scalar %s",
+			v,
+			name,
+		)
+		ex.scalars[name] = true
+		return name + "!"
+	case *parser.TypeStruct:
+		if input {
+			return ex.inputName(v) + "!"
+		}
+	case *parser.TypeUnion:
+		if input {
+			return ex.inputScalar(v, "union", subject)
+		}
+	case *parser.TypeTrait:
+		if input {
+			return ex.inputScalar(v, "trait", subject)
+		}
+	case parser.TypeStdNone:
+		ex.issue(subject, "None can't be represented")
+		return "Boolean"
+	}
+	return primitiveName(t, ex.scalars) + "!"
+}
+
+// inputScalar returns the reference to the custom scalar representing
+// the given union or trait type that's used as an input
+// since abstract types can only be outputs
+func (ex *exporter) inputScalar(
+	t parser.Type,
+	kind string,
+	subject string,
+) string {
+	name := generator.TypeName(t) + "Input"
+	ex.issue(
+		subject,
+		"%s %s can't be used as input and is represented "+
+			"by the custom scalar %s",
+		kind,
+		t,
+		name,
+	)
+	ex.scalars[name] = true
+	return name + "!"
+}
+
+// primitiveName returns the GraphQL name of the given type
+// marking primitives that aren't built into GraphQL as used scalars
+func primitiveName(t parser.Type, scalars map[string]bool) string {
+	switch t.(type) {
+	case parser.TypeStdBool:
+		return "Boolean"
+	case parser.TypeStdByte,
+		parser.TypeStdInt8,
+		parser.TypeStdInt16,
+		parser.TypeStdInt32,
+		parser.TypeStdUint8,
+		parser.TypeStdUint16:
+		return "Int"
+	case parser.TypeStdFloat32, parser.TypeStdFloat64:
+		return "Float"
+	case parser.TypeStdString:
+		return "String"
+	case parser.TypeStdInt64,
+		parser.TypeStdUint32,
+		parser.TypeStdUint64,
+		parser.TypeStdTime,
+		parser.TypeStdDate,
+		parser.TypeStdDuration,
+		parser.TypeStdUUID,
+		parser.TypeStdDecimal:
+		scalars[t.String()] = true
+		return t.String()
+	}
+	return generator.TypeName(t)
+}
+
+// isNoneAlias returns true if the given type is an alias of None.
+// Aliases of None usually represent errors and are exported as objects
+func isNoneAlias(t parser.Type) bool {
+	if _, isAlias := t.(*parser.TypeAlias); !isAlias {
+		return false
+	}
+	_, isNone := generator.Unaliased(t).(parser.TypeStdNone)
+	return isNone
+}
+
+// value returns the GraphQL literal of the given value
+func value(v *parser.Value) string {
+	switch v.Kind {
+	case parser.ValueKindNull:
+		return "null"
+	case parser.ValueKindString:
+		return quote(v.Literal)
+	case parser.ValueKindList:
+		items := make([]string, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = value(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case parser.ValueKindStruct:
+		fields := make([]string, len(v.Fields))
+		for i, fld := range v.Fields {
+			fields[i] = fld.Name + ": " + value(fld.Value)
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return v.Literal
+}
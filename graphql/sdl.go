@@ -0,0 +1,128 @@
+package graphql
+
+// sdlType represents a GraphQL type reference
+type sdlType struct {
+	// Name is the name of a named type reference
+	// and is empty for list type references
+	Name string
+
+	// OfType is the item type of a list type reference
+	OfType *sdlType
+
+	NonNull bool
+}
+
+// sdlValueKind identifies the kind of a GraphQL value literal
+type sdlValueKind int
+
+const (
+	sdlValueInt sdlValueKind = iota
+	sdlValueFloat
+	sdlValueString
+	sdlValueBool
+	sdlValueNull
+	sdlValueEnum
+	sdlValueList
+	sdlValueObject
+	sdlValueVariable
+)
+
+// sdlValue represents a GraphQL value literal
+type sdlValue struct {
+	Kind    sdlValueKind
+	Literal string
+	Items   []*sdlValue
+	Fields  []*sdlObjectField
+}
+
+// sdlObjectField represents a field of an object value literal
+type sdlObjectField struct {
+	Name  string
+	Value *sdlValue
+}
+
+// sdlDirective represents an applied directive
+type sdlDirective struct {
+	Name      string
+	Arguments []*sdlObjectField
+}
+
+// sdlInputValue represents either an argument or an input object field
+type sdlInputValue struct {
+	Description string
+	Name        string
+	Type        *sdlType
+	Default     *sdlValue
+	Directives  []*sdlDirective
+}
+
+// sdlField represents a field of an object or an interface
+type sdlField struct {
+	Description string
+	Name        string
+	Arguments   []*sdlInputValue
+	Type        *sdlType
+	Directives  []*sdlDirective
+}
+
+// sdlEnumValue represents an enum value definition
+type sdlEnumValue struct {
+	Description string
+	Name        string
+	Directives  []*sdlDirective
+}
+
+// sdlDefinition represents a type system definition or extension
+type sdlDefinition struct {
+	// Keyword is the keyword introducing the definition
+	// such as type, input or schema
+	Keyword     string
+	Extension   bool
+	Description string
+	Name        string
+	Line        int
+	Directives  []*sdlDirective
+
+	// Interfaces lists the implemented interfaces
+	// of objects and interfaces
+	Interfaces []string
+
+	// Fields lists the fields of objects and interfaces
+	Fields []*sdlField
+
+	// InputFields lists the fields of input objects
+	// and the arguments of directive definitions
+	InputFields []*sdlInputValue
+
+	// Members lists the member types of unions
+	Members []string
+
+	// Values lists the values of enums
+	Values []*sdlEnumValue
+
+	// Operations maps the operation types of schema definitions
+	// to the names of their root types
+	Operations map[string]string
+}
+
+// directive returns the applied directive of the given name
+// or nil if there's no such directive
+func directive(directives []*sdlDirective, name string) *sdlDirective {
+	for _, d := range directives {
+		if d.Name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// argument returns the value of the argument of the given name
+// or nil if there's no such argument
+func (d *sdlDirective) argument(name string) *sdlValue {
+	for _, arg := range d.Arguments {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// sdlTokenKind identifies the kind of a GraphQL SDL token
+type sdlTokenKind int
+
+const (
+	sdlTkEOF sdlTokenKind = iota
+	sdlTkPunct
+	sdlTkName
+	sdlTkInt
+	sdlTkFloat
+	sdlTkString
+	sdlTkBlockString
+)
+
+// sdlToken represents a lexical token of GraphQL SDL
+type sdlToken struct {
+	kind sdlTokenKind
+
+	// src is the source of the token.
+	// The src of string tokens holds the decoded value
+	src    string
+	line   int
+	column int
+}
+
+// String stringifies the token for error messages
+func (tk sdlToken) String() string {
+	switch tk.kind {
+	case sdlTkEOF:
+		return "end of file"
+	case sdlTkString, sdlTkBlockString:
+		return "string " + strconv.Quote(tk.src)
+	}
+	return "'" + tk.src + "'"
+}
+
+// byteOrderMark is the unicode byte order mark ignored by the lexer
+const byteOrderMark = "\uFEFF"
+
+// sdlLexer splits GraphQL SDL into tokens
+type sdlLexer struct {
+	src    string
+	pos    int
+	line   int
+	column int
+}
+
+// newSDLLexer creates a new lexer reading the given source
+func newSDLLexer(src string) *sdlLexer {
+	return &sdlLexer{src: src, line: 1, column: 1}
+}
+
+// errorf returns a syntax error at the given position
+func errorf(line, column int, format string, v ...interface{}) error {
+	return fmt.Errorf(
+		"syntax error at %d:%d: %s",
+		line,
+		column,
+		fmt.Sprintf(format, v...),
+	)
+}
+
+// advance moves the cursor n bytes ahead keeping track of lines
+func (lx *sdlLexer) advance(n int) {
+	for ; n > 0 && lx.pos < len(lx.src); n-- {
+		if lx.src[lx.pos] == '\n' {
+			lx.line++
+			lx.column = 0
+		}
+		lx.pos++
+		lx.column++
+	}
+}
+
+// skipIgnored skips white space, line terminators, commas and comments
+// which are all insignificant in GraphQL
+func (lx *sdlLexer) skipIgnored() {
+	for lx.pos < len(lx.src) {
+		switch lx.src[lx.pos] {
+		case ' ', '\t', '\n', '\r', ',':
+			lx.advance(1)
+		case '#':
+			for lx.pos < len(lx.src) &&
+				lx.src[lx.pos] != '\n' &&
+				lx.src[lx.pos] != '\r' {
+				lx.advance(1)
+			}
+		default:
+			if strings.HasPrefix(lx.src[lx.pos:], byteOrderMark) {
+				lx.advance(len(byteOrderMark))
+				continue
+			}
+			return
+		}
+	}
+}
+
+// next reads the next token
+func (lx *sdlLexer) next() (sdlToken, error) {
+	lx.skipIgnored()
+	tk := sdlToken{line: lx.line, column: lx.column}
+	if lx.pos >= len(lx.src) {
+		return tk, nil
+	}
+
+	c := lx.src[lx.pos]
+	switch {
+	case strings.HasPrefix(lx.src[lx.pos:], "..."):
+		tk.kind = sdlTkPunct
+		tk.src = "..."
+		lx.advance(3)
+		return tk, nil
+
+	case strings.IndexByte("!$&():=@[]{|}", c) > -1:
+		tk.kind = sdlTkPunct
+		tk.src = string(c)
+		lx.advance(1)
+		return tk, nil
+
+	case c == '_' || isLetter(c):
+		begin := lx.pos
+		for lx.pos < len(lx.src) && isNameChar(lx.src[lx.pos]) {
+			lx.advance(1)
+		}
+		tk.kind = sdlTkName
+		tk.src = lx.src[begin:lx.pos]
+		return tk, nil
+
+	case c == '-' || isDigit(c):
+		return lx.readNumber(tk)
+
+	case strings.HasPrefix(lx.src[lx.pos:], `"""`):
+		return lx.readBlockString(tk)
+
+	case c == '"':
+		return lx.readString(tk)
+	}
+
+	r, _ := utf8.DecodeRuneInString(lx.src[lx.pos:])
+	return tk, errorf(tk.line, tk.column, "unexpected character %q", r)
+}
+
+// readNumber reads an integer or a float literal
+func (lx *sdlLexer) readNumber(tk sdlToken) (sdlToken, error) {
+	begin := lx.pos
+	tk.kind = sdlTkInt
+	if lx.src[lx.pos] == '-' {
+		lx.advance(1)
+	}
+	digits := func() int {
+		n := 0
+		for lx.pos < len(lx.src) && isDigit(lx.src[lx.pos]) {
+			lx.advance(1)
+			n++
+		}
+		return n
+	}
+	if digits() < 1 {
+		return tk, errorf(tk.line, tk.column, "malformed number")
+	}
+	if lx.pos < len(lx.src) && lx.src[lx.pos] == '.' {
+		tk.kind = sdlTkFloat
+		lx.advance(1)
+		if digits() < 1 {
+			return tk, errorf(tk.line, tk.column, "malformed number")
+		}
+	}
+	if lx.pos < len(lx.src) &&
+		(lx.src[lx.pos] == 'e' || lx.src[lx.pos] == 'E') {
+		tk.kind = sdlTkFloat
+		lx.advance(1)
+		if lx.pos < len(lx.src) &&
+			(lx.src[lx.pos] == '+' || lx.src[lx.pos] == '-') {
+			lx.advance(1)
+		}
+		if digits() < 1 {
+			return tk, errorf(tk.line, tk.column, "malformed number")
+		}
+	}
+	if lx.pos < len(lx.src) && isNameChar(lx.src[lx.pos]) {
+		return tk, errorf(tk.line, tk.column, "malformed number")
+	}
+	tk.src = lx.src[begin:lx.pos]
+	return tk, nil
+}
+
+// readString reads a string literal decoding its escape sequences
+func (lx *sdlLexer) readString(tk sdlToken) (sdlToken, error) {
+	tk.kind = sdlTkString
+	lx.advance(1)
+	b := strings.Builder{}
+	for {
+		if lx.pos >= len(lx.src) ||
+			lx.src[lx.pos] == '\n' ||
+			lx.src[lx.pos] == '\r' {
+			return tk, errorf(tk.line, tk.column, "unterminated string")
+		}
+		c := lx.src[lx.pos]
+		if c == '"' {
+			lx.advance(1)
+			break
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			lx.advance(1)
+			continue
+		}
+
+		// Escape sequence
+		if lx.pos+1 >= len(lx.src) {
+			return tk, errorf(tk.line, tk.column, "unterminated string")
+		}
+		switch esc := lx.src[lx.pos+1]; esc {
+		case '"', '\\', '/':
+			b.WriteByte(esc)
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if lx.pos+6 > len(lx.src) {
+				return tk, errorf(
+					lx.line,
+					lx.column,
+					"malformed unicode escape sequence",
+				)
+			}
+			code, err := strconv.ParseUint(lx.src[lx.pos+2:lx.pos+6], 16, 32)
+			if err != nil {
+				return tk, errorf(
+					lx.line,
+					lx.column,
+					"malformed unicode escape sequence",
+				)
+			}
+			b.WriteRune(rune(code))
+			lx.advance(4)
+		default:
+			return tk, errorf(
+				lx.line,
+				lx.column,
+				"illegal escape sequence \\%c",
+				esc,
+			)
+		}
+		lx.advance(2)
+	}
+	tk.src = b.String()
+	return tk, nil
+}
+
+// readBlockString reads a block string literal
+// removing its common indentation
+func (lx *sdlLexer) readBlockString(tk sdlToken) (sdlToken, error) {
+	tk.kind = sdlTkBlockString
+	lx.advance(3)
+	b := strings.Builder{}
+	for {
+		if lx.pos >= len(lx.src) {
+			return tk, errorf(tk.line, tk.column, "unterminated block string")
+		}
+		rest := lx.src[lx.pos:]
+		if strings.HasPrefix(rest, `"""`) {
+			lx.advance(3)
+			break
+		}
+		if strings.HasPrefix(rest, `\"""`) {
+			b.WriteString(`"""`)
+			lx.advance(4)
+			continue
+		}
+		b.WriteByte(lx.src[lx.pos])
+		lx.advance(1)
+	}
+	tk.src = blockStringValue(b.String())
+	return tk, nil
+}
+
+// blockStringValue removes the common indentation
+// and the leading and trailing blank lines of a block string
+func blockStringValue(raw string) string {
+	raw = strings.Replace(raw, "\r\n", "\n", -1)
+	lines := strings.Split(strings.Replace(raw, "\r", "\n", -1), "\n")
+
+	// Determine the common indentation ignoring the first line
+	common := -1
+	for _, line := range lines[1:] {
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < len(line) && (common < 0 || indent < common) {
+			common = indent
+		}
+	}
+	if common > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) < common {
+				lines[i] = ""
+				continue
+			}
+			lines[i] = lines[i][common:]
+		}
+	}
+
+	// Remove leading and trailing blank lines
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isLetter returns true if c is a latin letter
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isDigit returns true if c is a decimal digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isNameChar returns true if c can be part of a GraphQL name
+func isNameChar(c byte) bool {
+	return c == '_' || isLetter(c) || isDigit(c)
+}
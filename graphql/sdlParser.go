@@ -0,0 +1,502 @@
+package graphql
+
+// sdlParser parses GraphQL SDL documents
+type sdlParser struct {
+	lex *sdlLexer
+
+	// tk is the current token
+	tk sdlToken
+}
+
+// parseSDL parses the given GraphQL SDL document
+// returning its type system definitions
+func parseSDL(src string) ([]*sdlDefinition, error) {
+	p := &sdlParser{lex: newSDLLexer(src)}
+	if err := p.read(); err != nil {
+		return nil, err
+	}
+	defs := []*sdlDefinition{}
+	for p.tk.kind != sdlTkEOF {
+		def, err := p.parseDefinition()
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// read reads the next token
+func (p *sdlParser) read() (err error) {
+	p.tk, err = p.lex.next()
+	return
+}
+
+// unexpected returns an error describing the current token as unexpected
+func (p *sdlParser) unexpected(expected string) error {
+	return errorf(
+		p.tk.line,
+		p.tk.column,
+		"unexpected %s, expected %s",
+		p.tk,
+		expected,
+	)
+}
+
+// is returns true if the current token is the given punctuator
+func (p *sdlParser) is(punct string) bool {
+	return p.tk.kind == sdlTkPunct && p.tk.src == punct
+}
+
+// skip reads the given punctuator if it's the current token
+// returning true if it was read
+func (p *sdlParser) skip(punct string) (bool, error) {
+	if !p.is(punct) {
+		return false, nil
+	}
+	return true, p.read()
+}
+
+// expect reads the given punctuator
+func (p *sdlParser) expect(punct string) error {
+	if !p.is(punct) {
+		return p.unexpected("'" + punct + "'")
+	}
+	return p.read()
+}
+
+// name reads a name
+func (p *sdlParser) name() (string, error) {
+	if p.tk.kind != sdlTkName {
+		return "", p.unexpected("name")
+	}
+	name := p.tk.src
+	return name, p.read()
+}
+
+// optDescription reads the optional description
+// preceding a definition if there's any
+func (p *sdlParser) optDescription() (string, error) {
+	if p.tk.kind != sdlTkString && p.tk.kind != sdlTkBlockString {
+		return "", nil
+	}
+	description := p.tk.src
+	return description, p.read()
+}
+
+// parseDefinition parses a type system definition or extension
+func (p *sdlParser) parseDefinition() (*sdlDefinition, error) {
+	description, err := p.optDescription()
+	if err != nil {
+		return nil, err
+	}
+	def := &sdlDefinition{Description: description, Line: p.tk.line}
+	if p.tk.kind == sdlTkName && p.tk.src == "extend" {
+		def.Extension = true
+		if err := p.read(); err != nil {
+			return nil, err
+		}
+	}
+	if p.tk.kind != sdlTkName {
+		return nil, p.unexpected("definition")
+	}
+	def.Keyword = p.tk.src
+	if err := p.read(); err != nil {
+		return nil, err
+	}
+
+	switch def.Keyword {
+	case "schema":
+		err = p.parseSchema(def)
+	case "scalar":
+		err = p.parseNameAndDirectives(def)
+	case "type", "interface":
+		err = p.parseObject(def)
+	case "union":
+		err = p.parseUnion(def)
+	case "enum":
+		err = p.parseEnum(def)
+	case "input":
+		err = p.parseInput(def)
+	case "directive":
+		err = p.parseDirectiveDefinition(def)
+	default:
+		return nil, errorf(
+			def.Line,
+			1,
+			"unexpected %q, expected type system definition",
+			def.Keyword,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+// parseNameAndDirectives parses the name of a definition
+// followed by optional directives
+func (p *sdlParser) parseNameAndDirectives(def *sdlDefinition) (err error) {
+	if def.Name, err = p.name(); err != nil {
+		return
+	}
+	def.Directives, err = p.parseDirectives()
+	return
+}
+
+// parseSchema parses the body of a schema definition
+func (p *sdlParser) parseSchema(def *sdlDefinition) (err error) {
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return
+	}
+	def.Operations = map[string]string{}
+	if !p.is("{") {
+		return
+	}
+	if err = p.read(); err != nil {
+		return
+	}
+	for !p.is("}") {
+		operation, err := p.name()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		if def.Operations[operation], err = p.name(); err != nil {
+			return err
+		}
+	}
+	return p.read()
+}
+
+// parseObject parses the body of an object or an interface definition
+func (p *sdlParser) parseObject(def *sdlDefinition) (err error) {
+	if def.Name, err = p.name(); err != nil {
+		return
+	}
+
+	// Parse the implemented interfaces
+	if p.tk.kind == sdlTkName && p.tk.src == "implements" {
+		if err = p.read(); err != nil {
+			return
+		}
+		if _, err = p.skip("&"); err != nil {
+			return
+		}
+		for {
+			iface, err := p.name()
+			if err != nil {
+				return err
+			}
+			def.Interfaces = append(def.Interfaces, iface)
+			amp, err := p.skip("&")
+			if err != nil {
+				return err
+			}
+			if !amp {
+				break
+			}
+		}
+	}
+
+	if def.Directives, err = p.parseDirectives(); err != nil {
+		return
+	}
+	if !p.is("{") {
+		return
+	}
+	if err = p.read(); err != nil {
+		return
+	}
+	for !p.is("}") {
+		fld := &sdlField{}
+		if fld.Description, err = p.optDescription(); err != nil {
+			return
+		}
+		if fld.Name, err = p.name(); err != nil {
+			return
+		}
+		if p.is("(") {
+			if fld.Arguments, err = p.parseInputValues("(", ")"); err != nil {
+				return
+			}
+		}
+		if err = p.expect(":"); err != nil {
+			return
+		}
+		if fld.Type, err = p.parseType(); err != nil {
+			return
+		}
+		if fld.Directives, err = p.parseDirectives(); err != nil {
+			return
+		}
+		def.Fields = append(def.Fields, fld)
+	}
+	return p.read()
+}
+
+// parseUnion parses the body of a union definition
+func (p *sdlParser) parseUnion(def *sdlDefinition) (err error) {
+	if err = p.parseNameAndDirectives(def); err != nil {
+		return
+	}
+	if eq, err := p.skip("="); err != nil || !eq {
+		return err
+	}
+	if _, err = p.skip("|"); err != nil {
+		return
+	}
+	for {
+		member, err := p.name()
+		if err != nil {
+			return err
+		}
+		def.Members = append(def.Members, member)
+		if pipe, err := p.skip("|"); err != nil || !pipe {
+			return err
+		}
+	}
+}
+
+// parseEnum parses the body of an enum definition
+func (p *sdlParser) parseEnum(def *sdlDefinition) (err error) {
+	if err = p.parseNameAndDirectives(def); err != nil {
+		return
+	}
+	if !p.is("{") {
+		return
+	}
+	if err = p.read(); err != nil {
+		return
+	}
+	for !p.is("}") {
+		val := &sdlEnumValue{}
+		if val.Description, err = p.optDescription(); err != nil {
+			return
+		}
+		if val.Name, err = p.name(); err != nil {
+			return
+		}
+		if val.Directives, err = p.parseDirectives(); err != nil {
+			return
+		}
+		def.Values = append(def.Values, val)
+	}
+	return p.read()
+}
+
+// parseInput parses the body of an input object definition
+func (p *sdlParser) parseInput(def *sdlDefinition) (err error) {
+	if err = p.parseNameAndDirectives(def); err != nil {
+		return
+	}
+	if !p.is("{") {
+		return
+	}
+	def.InputFields, err = p.parseInputValues("{", "}")
+	return
+}
+
+// parseDirectiveDefinition parses the body of a directive definition
+func (p *sdlParser) parseDirectiveDefinition(def *sdlDefinition) (err error) {
+	if err = p.expect("@"); err != nil {
+		return
+	}
+	if def.Name, err = p.name(); err != nil {
+		return
+	}
+	if p.is("(") {
+		if def.InputFields, err = p.parseInputValues("(", ")"); err != nil {
+			return
+		}
+	}
+	if p.tk.kind == sdlTkName && p.tk.src == "repeatable" {
+		if err = p.read(); err != nil {
+			return
+		}
+	}
+	if p.tk.kind != sdlTkName || p.tk.src != "on" {
+		return p.unexpected("'on'")
+	}
+	if err = p.read(); err != nil {
+		return
+	}
+	if _, err = p.skip("|"); err != nil {
+		return
+	}
+	for {
+		if _, err := p.name(); err != nil {
+			return err
+		}
+		if pipe, err := p.skip("|"); err != nil || !pipe {
+			return err
+		}
+	}
+}
+
+// parseInputValues parses a list of arguments or input object fields
+// enclosed by the given punctuators
+func (p *sdlParser) parseInputValues(
+	opening string,
+	closing string,
+) ([]*sdlInputValue, error) {
+	if err := p.expect(opening); err != nil {
+		return nil, err
+	}
+	vals := []*sdlInputValue{}
+	for !p.is(closing) {
+		var err error
+		val := &sdlInputValue{}
+		if val.Description, err = p.optDescription(); err != nil {
+			return nil, err
+		}
+		if val.Name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if val.Type, err = p.parseType(); err != nil {
+			return nil, err
+		}
+		if p.is("=") {
+			if err := p.read(); err != nil {
+				return nil, err
+			}
+			if val.Default, err = p.parseValue(); err != nil {
+				return nil, err
+			}
+		}
+		if val.Directives, err = p.parseDirectives(); err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, p.read()
+}
+
+// parseType parses a type reference
+func (p *sdlParser) parseType() (*sdlType, error) {
+	t := &sdlType{}
+	if p.is("[") {
+		if err := p.read(); err != nil {
+			return nil, err
+		}
+		item, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		t.OfType = item
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		t.Name = name
+	}
+	nonNull, err := p.skip("!")
+	t.NonNull = nonNull
+	return t, err
+}
+
+// parseDirectives parses optional applied directives
+func (p *sdlParser) parseDirectives() ([]*sdlDirective, error) {
+	var directives []*sdlDirective
+	for p.is("@") {
+		if err := p.read(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		d := &sdlDirective{Name: name}
+		if p.is("(") {
+			if d.Arguments, err = p.parseObjectFields("(", ")"); err != nil {
+				return nil, err
+			}
+		}
+		directives = append(directives, d)
+	}
+	return directives, nil
+}
+
+// parseObjectFields parses a list of name-value pairs
+// enclosed by the given punctuators
+func (p *sdlParser) parseObjectFields(
+	opening string,
+	closing string,
+) ([]*sdlObjectField, error) {
+	if err := p.expect(opening); err != nil {
+		return nil, err
+	}
+	fields := []*sdlObjectField{}
+	for !p.is(closing) {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		val, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, &sdlObjectField{Name: name, Value: val})
+	}
+	return fields, p.read()
+}
+
+// parseValue parses a value literal
+func (p *sdlParser) parseValue() (*sdlValue, error) {
+	tk := p.tk
+	switch tk.kind {
+	case sdlTkInt:
+		return &sdlValue{Kind: sdlValueInt, Literal: tk.src}, p.read()
+	case sdlTkFloat:
+		return &sdlValue{Kind: sdlValueFloat, Literal: tk.src}, p.read()
+	case sdlTkString, sdlTkBlockString:
+		return &sdlValue{Kind: sdlValueString, Literal: tk.src}, p.read()
+	case sdlTkName:
+		kind := sdlValueEnum
+		switch tk.src {
+		case "true", "false":
+			kind = sdlValueBool
+		case "null":
+			kind = sdlValueNull
+		}
+		return &sdlValue{Kind: kind, Literal: tk.src}, p.read()
+	}
+
+	switch {
+	case p.is("$"):
+		if err := p.read(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		return &sdlValue{Kind: sdlValueVariable, Literal: name}, err
+	case p.is("["):
+		if err := p.read(); err != nil {
+			return nil, err
+		}
+		val := &sdlValue{Kind: sdlValueList, Items: []*sdlValue{}}
+		for !p.is("]") {
+			item, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			val.Items = append(val.Items, item)
+		}
+		return val, p.read()
+	case p.is("{"):
+		fields, err := p.parseObjectFields("{", "}")
+		return &sdlValue{Kind: sdlValueObject, Fields: fields}, err
+	}
+	return nil, p.unexpected("value")
+}
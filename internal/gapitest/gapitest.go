@@ -0,0 +1,33 @@
+// Package gapitest provides helpers for testing packages
+// operating on compiled schema models
+package gapitest
+
+import (
+	"testing"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/stretchr/testify/require"
+)
+
+// Parse parses the given schema source failing the test
+// if it doesn't parse and returns the parser
+func Parse(t *testing.T, source string) *parser.Parser {
+	pr, err := parser.NewParser()
+	require.NoError(t, err)
+	require.NoError(t, pr.Parse(parser.SourceFile{
+		File: parser.File{
+			Name: "test.gapi",
+			Path: "/tests/",
+		},
+		Src: source,
+	}))
+	return pr
+}
+
+// Compile compiles the given schema source failing the test
+// if it doesn't compile and returns the schema model
+func Compile(t *testing.T, source string) *parser.SchemaModel {
+	mod := Parse(t, source).SchemaModel()
+	require.NotNil(t, mod)
+	return mod
+}
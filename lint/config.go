@@ -0,0 +1,57 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// Config represents a linter configuration
+type Config struct {
+	// Rules maps rule names to rule configurations.
+	// Rules that aren't configured are enabled and reported as warnings
+	Rules map[string]RuleConfig `json:"rules"`
+}
+
+// RuleConfig represents the configuration of a single rule
+type RuleConfig struct {
+	// Enabled disables the rule when set to false
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Severity is either "error" or "warning" (default)
+	Severity string `json:"severity,omitempty"`
+}
+
+// ParseConfig parses a JSON encoded linter configuration
+func ParseConfig(data []byte) (Config, error) {
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("parsing lint configuration: %s", err)
+	}
+	return config, nil
+}
+
+// LoadConfig loads a JSON encoded linter configuration from a file
+func LoadConfig(path string) (Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading lint configuration: %s", err)
+	}
+	return ParseConfig(data)
+}
+
+// parseSeverity parses a configured severity level
+func parseSeverity(severity string) (parser.Severity, error) {
+	switch severity {
+	case "", parser.SeverityWarning.String():
+		return parser.SeverityWarning, nil
+	case parser.SeverityError.String():
+		return parser.SeverityError, nil
+	}
+	return 0, fmt.Errorf(
+		"illegal severity '%s' (expected either error or warning)",
+		severity,
+	)
+}
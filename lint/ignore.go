@@ -0,0 +1,204 @@
+package lint
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// IgnoreAnnotation is the name of the annotation suppressing violations
+// of the annotated declaration and its members. Without arguments
+// all rules are suppressed, otherwise only the given ones
+const IgnoreAnnotation = "lintIgnore"
+
+// IgnoreAnnotationSchema is the schema of the ignore annotation
+// to be registered in parsers validating annotations
+var IgnoreAnnotationSchema = parser.AnnotationSchema{
+	Name: IgnoreAnnotation,
+	Params: []parser.AnnotationParam{
+		{Name: "rule", Type: parser.TypeStdString{}, Optional: true},
+	},
+	Repeatable: true,
+}
+
+// ignoreCommentPrefix is the prefix of comments suppressing violations
+// of declarations on the same line, or on the following line if the
+// comment is on a line of its own. Without rule names all rules
+// are suppressed
+const ignoreCommentPrefix = "lint:ignore"
+
+// isIgnoredByAnnotation returns true if either the declaration
+// or any of its parents is annotated to ignore the given rule
+func isIgnoredByAnnotation(decl Declaration, rule string) bool {
+	for decl != nil {
+		for _, annotation := range annotationsOf(decl) {
+			if annotation.Name != IgnoreAnnotation {
+				continue
+			}
+			if len(annotation.Args) < 1 {
+				return true
+			}
+			for _, arg := range annotation.Args {
+				if arg.Kind == parser.ValueKindString && arg.Literal == rule {
+					return true
+				}
+			}
+		}
+		decl = parentOf(decl)
+	}
+	return false
+}
+
+// annotationsOf returns the annotations of the given declaration
+func annotationsOf(decl Declaration) []*parser.Annotation {
+	switch v := decl.(type) {
+	case *parser.TypeAlias:
+		return v.Annotations
+	case *parser.TypeScalar:
+		return v.Annotations
+	case *parser.TypeEnum:
+		return v.Annotations
+	case *parser.TypeUnion:
+		return v.Annotations
+	case *parser.TypeStruct:
+		return v.Annotations
+	case *parser.TypeResolver:
+		return v.Annotations
+	case *parser.TypeTrait:
+		return v.Annotations
+	case *parser.StructField:
+		return v.Annotations
+	case *parser.ResolverProperty:
+		return v.Annotations
+	case *parser.TraitProperty:
+		return v.Annotations
+	case *parser.Query:
+		return v.Annotations
+	case *parser.Mutation:
+		return v.Annotations
+	case *parser.Subscription:
+		return v.Annotations
+	case *parser.Parameter:
+		return v.Annotations
+	}
+	return nil
+}
+
+// parentOf returns the declaration the given declaration is a member of
+// or nil if it's a top-level declaration
+func parentOf(decl Declaration) Declaration {
+	switch v := decl.(type) {
+	case *parser.StructField:
+		return v.Struct
+	case *parser.ResolverProperty:
+		return v.Resolver
+	case *parser.TraitProperty:
+		return v.Trait
+	case *parser.Parameter:
+		if v.Target != nil {
+			return v.Target
+		}
+	}
+	return nil
+}
+
+// ignoredLines maps file lines to the rules ignored on them.
+// A nil rule list ignores all rules
+type ignoredLines map[*parser.File]map[uint32][]string
+
+// ignores returns true if the given rule is ignored at the given position
+func (ls ignoredLines) ignores(at parser.Cursor, rule string) bool {
+	rules, isIgnored := ls[at.File][at.Line]
+	if !isIgnored {
+		return false
+	}
+	if rules == nil {
+		return true
+	}
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// ignoreComments collects all ignore comments of the given fragment trees.
+// A comment on a line of its own applies to the following line
+// while any other comment applies to the line it's on
+func ignoreComments(frags []parser.Fragment) ignoredLines {
+	// Collect the tokens in order of appearance
+	tokens := []parser.Fragment{}
+	var walk func(frag parser.Fragment)
+	walk = func(frag parser.Fragment) {
+		if frag == nil {
+			return
+		}
+		elements := frag.Elements()
+		if len(elements) < 1 {
+			tokens = append(tokens, frag)
+			return
+		}
+		for _, element := range elements {
+			walk(element)
+		}
+	}
+	for _, frag := range frags {
+		walk(frag)
+	}
+
+	lines := ignoredLines{}
+	for i, tk := range tokens {
+		if tk.FragID() != parser.FragTkComment {
+			continue
+		}
+		rules, isIgnore := parseIgnoreComment(tk.Src())
+		if !isIgnore {
+			continue
+		}
+		begin, end := tk.Begin(), tk.End()
+		if lines[end.File] == nil {
+			lines[end.File] = map[uint32][]string{}
+		}
+		trailing := i > 0 && sameLine(tokens[i-1].End(), begin)
+		leading := i+1 < len(tokens) && sameLine(end, tokens[i+1].Begin())
+		if !trailing && !leading {
+			lines[end.File][end.Line+1] = rules
+			continue
+		}
+		lines[end.File][begin.Line] = rules
+		lines[end.File][end.Line] = rules
+	}
+	return lines
+}
+
+// sameLine returns true if both cursors are on the same line
+// of the same file
+func sameLine(a, b parser.Cursor) bool {
+	return a.File == b.File && a.Line == b.Line
+}
+
+// parseIgnoreComment returns the rules ignored by the given comment
+// and true if it's an ignore comment, otherwise returns false
+func parseIgnoreComment(comment string) ([]string, bool) {
+	if strings.HasPrefix(comment, "//") {
+		comment = comment[2:]
+	} else {
+		comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/")
+	}
+	comment = strings.TrimSpace(comment)
+	if !strings.HasPrefix(comment, ignoreCommentPrefix) {
+		return nil, false
+	}
+	rest := comment[len(ignoreCommentPrefix):]
+	if rest != "" && !unicode.IsSpace(rune(rest[0])) {
+		// The prefix is part of a longer word such as lint:ignored
+		return nil, false
+	}
+	rules := strings.Fields(rest)
+	if len(rules) < 1 {
+		return nil, true
+	}
+	return rules, true
+}
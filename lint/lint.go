@@ -0,0 +1,125 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// Declaration represents a declaration violating a rule
+type Declaration interface {
+	Source() parser.Fragment
+}
+
+// Report reports a violation of a rule by the given declaration
+type Report func(decl Declaration, message string)
+
+// Rule represents a lint rule
+type Rule interface {
+	// Name returns the unique name of the rule
+	Name() string
+
+	// Description returns a short description of the rule
+	Description() string
+
+	// Check checks the schema reporting every violation of the rule
+	Check(schema *Schema, report Report)
+}
+
+// Schema represents a compiled schema to be linted
+type Schema struct {
+	Model *parser.SchemaModel
+
+	// Fragments are the fragment trees of all schema files
+	Fragments []parser.Fragment
+}
+
+// Violation represents a rule violation
+type Violation struct {
+	Rule     string
+	Severity parser.Severity
+	At       parser.Cursor
+	Message  string
+}
+
+// String stringifies the violation
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s at %s", v.Rule, v.Message, v.At)
+}
+
+// Linter represents a schema linter
+type Linter struct {
+	rules  []Rule
+	config Config
+}
+
+// New creates a new linter applying the given rules
+// according to the given configuration
+func New(config Config, rules ...Rule) (*Linter, error) {
+	byName := make(map[string]Rule, len(rules))
+	for _, rule := range rules {
+		if _, isDefined := byName[rule.Name()]; isDefined {
+			return nil, fmt.Errorf("duplicate rule %s", rule.Name())
+		}
+		byName[rule.Name()] = rule
+	}
+	for name, conf := range config.Rules {
+		if _, isDefined := byName[name]; !isDefined {
+			return nil, fmt.Errorf("configuration of unknown rule %s", name)
+		}
+		if _, err := parseSeverity(conf.Severity); err != nil {
+			return nil, fmt.Errorf("configuration of rule %s: %s", name, err)
+		}
+	}
+	return &Linter{
+		rules:  rules,
+		config: config,
+	}, nil
+}
+
+// Rules returns all rules of the linter
+func (l *Linter) Rules() []Rule {
+	rules := make([]Rule, len(l.rules))
+	copy(rules, l.rules)
+	return rules
+}
+
+// Lint checks the schema against all enabled rules returning
+// all unsuppressed violations ordered by their position
+func (l *Linter) Lint(schema *Schema) []Violation {
+	ignored := ignoreComments(schema.Fragments)
+	violations := []Violation{}
+
+	for _, rule := range l.rules {
+		conf := l.config.Rules[rule.Name()]
+		if conf.Enabled != nil && !*conf.Enabled {
+			continue
+		}
+		severity, _ := parseSeverity(conf.Severity)
+		name := rule.Name()
+
+		rule.Check(schema, func(decl Declaration, message string) {
+			at := decl.Source().Begin()
+			if isIgnoredByAnnotation(decl, name) ||
+				ignored.ignores(at, name) {
+				return
+			}
+			violations = append(violations, Violation{
+				Rule:     name,
+				Severity: severity,
+				At:       at,
+				Message:  message,
+			})
+		})
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		a, b := violations[i].At, violations[j].At
+		if a.File != b.File && a.File != nil && b.File != nil {
+			return a.File.Path+a.File.Name < b.File.Path+b.File.Name
+		}
+		return a.Index < b.Index
+	})
+	return violations
+}
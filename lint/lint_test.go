@@ -0,0 +1,268 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/internal/gapitest"
+	"github.com/romshark/gapi/lint"
+	"github.com/stretchr/testify/require"
+)
+
+type Violation struct {
+	Rule     string
+	Severity parser.Severity
+	Line     uint32
+	Message  string
+}
+
+func compile(t *testing.T, source string) *lint.Schema {
+	pr := gapitest.Parse(t, source)
+	return &lint.Schema{
+		Model:     pr.SchemaModel(),
+		Fragments: pr.Fragments(),
+	}
+}
+
+func test(
+	t *testing.T,
+	config lint.Config,
+	source string,
+	expected []Violation,
+) {
+	linter, err := lint.New(config, lint.Builtin()...)
+	require.NoError(t, err)
+
+	violations := linter.Lint(compile(t, source))
+	actual := make([]Violation, len(violations))
+	for i, v := range violations {
+		actual[i] = Violation{v.Rule, v.Severity, v.At.Line, v.Message}
+	}
+	require.Equal(t, expected, actual)
+}
+
+// TestBuiltinRules tests the built-in rules
+func TestBuiltinRules(t *testing.T) {
+	t.Run("Compliant", func(t *testing.T) {
+		test(t, lint.Config{}, `schema test
+		alias ErrNotFound = None
+		alias ErrInvalid = String
+		resolver User {
+			name String
+			friends(limit Uint32) []User
+			tags []String
+		}
+		union ResRename { User ErrNotFound ErrInvalid }
+		# q returns a user
+		query q ?User
+		# rename renames a user
+		mutation rename(name String) ResRename
+		# remove removes a user
+		mutation remove ?ErrNotFound`, []Violation{})
+	})
+
+	t.Run("ErrTypePrefix", func(t *testing.T) {
+		test(t, lint.Config{}, `schema test
+		alias NotFound = None
+		struct ErrInvalid { reason String }
+		union U { NotFound ErrInvalid String }
+		# q
+		query q U`, []Violation{
+			{
+				"err-type-prefix",
+				parser.SeverityWarning,
+				2,
+				"error type NotFound must be prefixed with Err",
+			},
+			{
+				"err-type-prefix",
+				parser.SeverityWarning,
+				3,
+				"error type ErrInvalid must be an alias",
+			},
+		})
+	})
+
+	t.Run("MutationResultErr", func(t *testing.T) {
+		test(t, lint.Config{}, `schema test
+		alias ErrA = None
+		union U { String Bool }
+		# a
+		mutation a String
+		# b
+		mutation b U
+		# c
+		mutation c ?ErrA`, []Violation{
+			{
+				"mutation-result-err",
+				parser.SeverityWarning,
+				5,
+				"result type String of mutation a isn't a union",
+			},
+			{
+				"mutation-result-err",
+				parser.SeverityWarning,
+				7,
+				"result type U of mutation b doesn't include an error type",
+			},
+		})
+	})
+
+	t.Run("PaginatedLists", func(t *testing.T) {
+		test(t, lint.Config{}, `schema test
+		trait T { items ?[]R }
+		resolver R {
+			items(offset Uint32) ?[]R
+			paginated(limit Uint32) []R
+			tags []String
+		}
+		# q
+		query q T`, []Violation{
+			{
+				"paginated-lists",
+				parser.SeverityWarning,
+				2,
+				"list property T.items isn't paginated (missing limit parameter)",
+			},
+			{
+				"paginated-lists",
+				parser.SeverityWarning,
+				4,
+				"list property R.items isn't paginated (missing limit parameter)",
+			},
+		})
+	})
+
+	t.Run("DocumentedEndpoints", func(t *testing.T) {
+		test(t, lint.Config{}, `schema test
+		query q String
+		alias ErrA = None
+		mutation m ?ErrA
+		subscription s String`, []Violation{
+			{
+				"documented-endpoints",
+				parser.SeverityWarning,
+				2,
+				"query q isn't documented",
+			},
+			{
+				"documented-endpoints",
+				parser.SeverityWarning,
+				4,
+				"mutation m isn't documented",
+			},
+			{
+				"documented-endpoints",
+				parser.SeverityWarning,
+				5,
+				"subscription s isn't documented",
+			},
+		})
+	})
+}
+
+// TestConfig tests rule configurations
+func TestConfig(t *testing.T) {
+	config, err := lint.ParseConfig([]byte(`{"rules": {
+		"documented-endpoints": {"enabled": false},
+		"err-type-prefix": {"severity": "error"}
+	}}`))
+	require.NoError(t, err)
+
+	test(t, config, `schema test
+	alias NotFound = None
+	query q ?NotFound`, []Violation{
+		{
+			"err-type-prefix",
+			parser.SeverityError,
+			2,
+			"error type NotFound must be prefixed with Err",
+		},
+	})
+}
+
+// TestConfigErrs tests illegal configurations
+func TestConfigErrs(t *testing.T) {
+	_, err := lint.ParseConfig([]byte(`{"rules": []}`))
+	require.Error(t, err)
+
+	for name, config := range map[string]string{
+		"UnknownRule":     `{"rules": {"unknown": {}}}`,
+		"IllegalSeverity": `{"rules": {"err-type-prefix": {"severity": "x"}}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			conf, err := lint.ParseConfig([]byte(config))
+			require.NoError(t, err)
+			linter, err := lint.New(conf, lint.Builtin()...)
+			require.Error(t, err)
+			require.Nil(t, linter)
+		})
+	}
+
+	t.Run("DuplicateRule", func(t *testing.T) {
+		linter, err := lint.New(
+			lint.Config{},
+			lint.DocumentedEndpoints{},
+			lint.DocumentedEndpoints{},
+		)
+		require.Error(t, err)
+		require.Nil(t, linter)
+	})
+}
+
+// TestSuppression tests suppressing violations
+// by annotations and comments
+func TestSuppression(t *testing.T) {
+	test(t, lint.Config{}, `schema test
+	@lintIgnore("err-type-prefix")
+	alias NotFound = None
+
+	@lintIgnore
+	resolver R {
+		items []R
+	}
+
+	resolver S {
+		@lintIgnore("paginated-lists")
+		a []S
+		b []S // lint:ignore paginated-lists
+		// lint:ignore documented-endpoints
+		c []S
+		/* lint:ignore */
+		d []S
+	}
+
+	resolver T {
+		a []T // lint:ignore
+		b []T
+	}
+
+	// lint:ignore
+	query q1 ?NotFound
+
+	# q2
+	// lint:ignore documented-endpoints err-type-prefix
+	query q2 S
+
+	// lint:ignored documented-endpoints
+	query q3 S`, []Violation{
+		{
+			"paginated-lists",
+			parser.SeverityWarning,
+			15,
+			"list property S.c isn't paginated (missing limit parameter)",
+		},
+		{
+			"paginated-lists",
+			parser.SeverityWarning,
+			22,
+			"list property T.b isn't paginated (missing limit parameter)",
+		},
+		{
+			"documented-endpoints",
+			parser.SeverityWarning,
+			33,
+			"query q3 isn't documented",
+		},
+	})
+}
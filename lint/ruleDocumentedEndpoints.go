@@ -0,0 +1,34 @@
+package lint
+
+import "fmt"
+
+// DocumentedEndpoints requires all endpoints to be documented
+type DocumentedEndpoints struct{}
+
+// Name implements the Rule interface
+func (DocumentedEndpoints) Name() string { return "documented-endpoints" }
+
+// Description implements the Rule interface
+func (DocumentedEndpoints) Description() string {
+	return "query, mutation and subscription endpoints must be documented"
+}
+
+// Check implements the Rule interface
+func (DocumentedEndpoints) Check(schema *Schema, report Report) {
+	const msg = "%s %s isn't documented"
+	for _, qry := range schema.Model.QueryEndpoints {
+		if qry.Doc == "" {
+			report(qry, fmt.Sprintf(msg, "query", qry.Name))
+		}
+	}
+	for _, mut := range schema.Model.Mutations {
+		if mut.Doc == "" {
+			report(mut, fmt.Sprintf(msg, "mutation", mut.Name))
+		}
+	}
+	for _, sub := range schema.Model.Subscriptions {
+		if sub.Doc == "" {
+			report(sub, fmt.Sprintf(msg, "subscription", sub.Name))
+		}
+	}
+}
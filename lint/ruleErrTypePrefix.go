@@ -0,0 +1,51 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// ErrTypePrefix requires aliases of None to be prefixed with Err
+// and types prefixed with Err to be aliases
+type ErrTypePrefix struct{}
+
+// Name implements the Rule interface
+func (ErrTypePrefix) Name() string { return "err-type-prefix" }
+
+// Description implements the Rule interface
+func (ErrTypePrefix) Description() string {
+	return "error types must be aliases prefixed with " + errTypePrefix
+}
+
+// Check implements the Rule interface
+func (ErrTypePrefix) Check(schema *Schema, report Report) {
+	for _, t := range schema.Model.Types {
+		if t.TerminalType() != nil {
+			// Anonymous type
+			continue
+		}
+		name := t.String()
+		alias, isAlias := t.(*parser.TypeAlias)
+		if !strings.HasPrefix(name, errTypePrefix) {
+			if !isAlias {
+				continue
+			}
+			if _, isNone := unaliased(alias).(parser.TypeStdNone); isNone {
+				report(alias, fmt.Sprintf(
+					"error type %s must be prefixed with %s",
+					name,
+					errTypePrefix,
+				))
+			}
+			continue
+		}
+		if !isAlias {
+			report(t, fmt.Sprintf(
+				"error type %s must be an alias",
+				name,
+			))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// MutationResultErr requires the results of mutations to be either
+// unions including at least one error type or optional error types
+type MutationResultErr struct{}
+
+// Name implements the Rule interface
+func (MutationResultErr) Name() string { return "mutation-result-err" }
+
+// Description implements the Rule interface
+func (MutationResultErr) Description() string {
+	return "mutation results must be unions including an error type " +
+		"or optional error types"
+}
+
+// Check implements the Rule interface
+func (MutationResultErr) Check(schema *Schema, report Report) {
+	for _, mut := range schema.Model.Mutations {
+		// Optional error types are results on their own
+		result := mut.Type
+		if opt, isOptional := result.(*parser.TypeOptional); isOptional {
+			result = opt.StoreType
+		}
+		if isErrType(result) {
+			continue
+		}
+
+		union, isUnion := unwrapped(mut.Type).(*parser.TypeUnion)
+		if !isUnion {
+			report(mut, fmt.Sprintf(
+				"result type %s of mutation %s isn't a union",
+				mut.Type,
+				mut.Name,
+			))
+			continue
+		}
+		hasErr := false
+		for _, option := range union.Types {
+			if isErrType(option) {
+				hasErr = true
+				break
+			}
+		}
+		if !hasErr {
+			report(mut, fmt.Sprintf(
+				"result type %s of mutation %s doesn't include an error type",
+				mut.Type,
+				mut.Name,
+			))
+		}
+	}
+}
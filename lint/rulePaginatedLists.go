@@ -0,0 +1,59 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// paginationParam is the name of the parameter paginating lists
+const paginationParam = "limit"
+
+// PaginatedLists requires properties of lists of impure types
+// to accept a limit parameter
+type PaginatedLists struct{}
+
+// Name implements the Rule interface
+func (PaginatedLists) Name() string { return "paginated-lists" }
+
+// Description implements the Rule interface
+func (PaginatedLists) Description() string {
+	return "properties of lists of resolvers must be paginated " +
+		"by a " + paginationParam + " parameter"
+}
+
+// Check implements the Rule interface
+func (PaginatedLists) Check(schema *Schema, report Report) {
+	check := func(
+		decl Declaration,
+		name string,
+		t parser.Type,
+		params []*parser.Parameter,
+	) {
+		list, isList := unwrapped(t).(*parser.TypeList)
+		if !isList || list.StoreType.IsPure() {
+			return
+		}
+		for _, param := range params {
+			if param.Name == paginationParam {
+				return
+			}
+		}
+		report(decl, fmt.Sprintf(
+			"list property %s isn't paginated (missing %s parameter)",
+			name,
+			paginationParam,
+		))
+	}
+
+	for _, t := range schema.Model.ResolverTypes {
+		for _, prop := range t.(*parser.TypeResolver).Properties {
+			check(prop, prop.GraphNodeName(), prop.Type, prop.Parameters)
+		}
+	}
+	for _, t := range schema.Model.TraitTypes {
+		for _, prop := range t.(*parser.TypeTrait).Properties {
+			check(prop, prop.GraphNodeName(), prop.Type, prop.Parameters)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/romshark/gapi/compiler/parser"
+)
+
+// Builtin returns all built-in rules
+func Builtin() []Rule {
+	return []Rule{
+		ErrTypePrefix{},
+		MutationResultErr{},
+		PaginatedLists{},
+		DocumentedEndpoints{},
+	}
+}
+
+// errTypePrefix is the prefix of error type names
+const errTypePrefix = "Err"
+
+// isErrType returns true if the given type is an alias prefixed with Err
+func isErrType(t parser.Type) bool {
+	_, isAlias := t.(*parser.TypeAlias)
+	return isAlias && strings.HasPrefix(t.String(), errTypePrefix)
+}
+
+// unaliased follows the alias chain returning the aliased type
+// or nil if the chain is cyclic
+func unaliased(t parser.Type) parser.Type {
+	visited := map[*parser.TypeAlias]bool{}
+	for {
+		alias, isAlias := t.(*parser.TypeAlias)
+		if !isAlias {
+			return t
+		}
+		if visited[alias] {
+			return nil
+		}
+		visited[alias] = true
+		t = alias.AliasedType
+	}
+}
+
+// unwrapped returns the unaliased store type of optional types
+// or the unaliased type itself if it's not optional
+func unwrapped(t parser.Type) parser.Type {
+	t = unaliased(t)
+	if opt, isOptional := t.(*parser.TypeOptional); isOptional {
+		return unaliased(opt.StoreType)
+	}
+	return t
+}
@@ -0,0 +1,225 @@
+// Package openapi exports schema models as JSON Schema
+// and OpenAPI documents describing their pure types and endpoints
+package openapi
+
+import (
+	"fmt"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// OpenAPIVersion is the OpenAPI version of the exported documents
+const OpenAPIVersion = "3.1.0"
+
+// DefaultAPIVersion is the default version of the described API
+const DefaultAPIVersion = "1.0.0"
+
+// Issue describes a construct that can't be described
+// by the exported document
+type Issue struct {
+	// Subject names the affected declaration
+	Subject string
+
+	Message string
+}
+
+// String stringifies the issue
+func (i Issue) String() string {
+	return i.Subject + ": " + i.Message
+}
+
+// Options defines the OpenAPI export options
+type Options struct {
+	// APIVersion is the version of the described API,
+	// DefaultAPIVersion is used if it's empty
+	APIVersion string
+}
+
+// Document represents an OpenAPI document
+type Document struct {
+	OpenAPI           string               `json:"openapi"`
+	Info              Info                 `json:"info"`
+	JSONSchemaDialect string               `json:"jsonSchemaDialect"`
+	Paths             map[string]*PathItem `json:"paths"`
+	Components        Components           `json:"components"`
+}
+
+// Info represents the metadata of the described API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem represents the operations available on a path
+type PathItem struct {
+	Post *Operation `json:"post,omitempty"`
+}
+
+// Operation represents an API operation
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// RequestBody represents the request body of an operation
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response represents a response of an operation
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType represents the schema of a request or response body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the definitions referenced by the document
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+// Export returns an OpenAPI document describing the given schema model.
+// Each query endpoint and mutation is described by an operation
+// expecting its parameters in the request body. The issues report
+// constructs that can't be described. Returns an error if the names
+// of the schema definitions collide
+func Export(mod *parser.SchemaModel, options Options) (
+	[]byte,
+	[]Issue,
+	error,
+) {
+	if options.APIVersion == "" {
+		options.APIVersion = DefaultAPIVersion
+	}
+	schemas, err := definitions(mod, "#/components/schemas/")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ex := &exporter{b: schemaBuilder{refPrefix: "#/components/schemas/"}}
+	doc := Document{
+		OpenAPI: OpenAPIVersion,
+		Info: Info{
+			Title:   mod.SchemaName,
+			Version: options.APIVersion,
+		},
+		JSONSchemaDialect: JSONSchemaDialect,
+		Paths:             map[string]*PathItem{},
+		Components:        Components{Schemas: schemas},
+	}
+	for _, q := range mod.QueryEndpoints {
+		doc.Paths["/query/"+q.Name] = &PathItem{Post: ex.operation(
+			"query",
+			q.Name,
+			q.Parameters,
+			q.Type,
+			q.Doc,
+			q.Deprecated,
+		)}
+	}
+	for _, m := range mod.Mutations {
+		doc.Paths["/mutation/"+m.Name] = &PathItem{Post: ex.operation(
+			"mutation",
+			m.Name,
+			m.Parameters,
+			m.Type,
+			m.Doc,
+			m.Deprecated,
+		)}
+	}
+	for _, s := range mod.Subscriptions {
+		ex.issue("subscription "+s.Name, "subscriptions can't be described")
+	}
+
+	encoded, err := encode(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encoded, ex.issues, nil
+}
+
+// exporter builds the operations of an OpenAPI document
+type exporter struct {
+	b      schemaBuilder
+	issues []Issue
+}
+
+// issue records a construct that can't be described
+func (ex *exporter) issue(subject, format string, v ...interface{}) {
+	ex.issues = append(ex.issues, Issue{
+		Subject: subject,
+		Message: fmt.Sprintf(format, v...),
+	})
+}
+
+// operation returns the operation of an endpoint.
+// The request body is an object of the parameters
+// which are required unless they're optional or have a default value.
+// Impure result types aren't described
+func (ex *exporter) operation(
+	tag string,
+	name string,
+	params []*parser.Parameter,
+	result parser.Type,
+	doc string,
+	deprecation *parser.Deprecation,
+) *Operation {
+	op := &Operation{
+		OperationID: name,
+		Tags:        []string{tag},
+		Description: doc,
+		Deprecated:  deprecation != nil,
+		Responses: map[string]*Response{
+			"200": {Description: "Success"},
+		},
+	}
+
+	if len(params) > 0 {
+		body := &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema, len(params)),
+		}
+		for _, param := range params {
+			s := ex.b.schema(param.Type)
+			ex.b.constrain(s, param.Type, param.Constraints)
+			s.Description = param.Doc
+			s.Default = param.Default
+			body.Properties[param.Name] = s
+
+			unaliased := generator.Unaliased(param.Type)
+			_, isOptional := unaliased.(*parser.TypeOptional)
+			if !isOptional && param.Default == nil {
+				body.Required = append(body.Required, param.Name)
+			}
+		}
+		op.RequestBody = &RequestBody{
+			Required: len(body.Required) > 0,
+			Content: map[string]*MediaType{
+				"application/json": {Schema: body},
+			},
+		}
+	}
+
+	if !result.IsPure() {
+		ex.issue(
+			tag+" "+name,
+			"result type %s is impure and can't be described",
+			result,
+		)
+		return op
+	}
+	op.Responses["200"].Content = map[string]*MediaType{
+		"application/json": {Schema: ex.b.schema(result)},
+	}
+	return op
+}
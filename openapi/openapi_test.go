@@ -0,0 +1,270 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/romshark/gapi/internal/gapitest"
+	"github.com/romshark/gapi/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `schema shop
+alias ErrNotFound = None
+alias Name = String
+scalar Cents Int64 where min(0)
+enum Color { red green }
+
+union Price {
+	Cents
+	String
+}
+
+# Item is a shop item
+struct Item {
+	name Name where maxLength(64)
+	color ?Color
+	tags []String where nonEmpty
+	prices [Color]Price
+	added Time
+}
+
+resolver Shop {
+	items []Item
+}
+
+union ResItem {
+	Item
+	ErrNotFound
+}
+
+# items returns all items
+query items(
+	# limit limits the number of items
+	limit Uint32 = 10,
+	color ?Color,
+) []Item
+
+query shop Shop
+
+deprecated("use add")
+mutation addItem(item Item) ResItem
+subscription added Item`
+
+// TestJSONSchema tests exporting JSON Schema definitions of pure types
+func TestJSONSchema(t *testing.T) {
+	doc, err := openapi.JSONSchema(gapitest.Compile(t, testSchema))
+	require.NoError(t, err)
+	require.Equal(t, `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "shop",
+	"$defs": {
+		"Cents": {
+			"type": "integer",
+			"format": "int64",
+			"minimum": 0
+		},
+		"Color": {
+			"type": "string",
+			"enum": [
+				"red",
+				"green"
+			]
+		},
+		"Item": {
+			"type": "object",
+			"description": "Item is a shop item",
+			"properties": {
+				"added": {
+					"type": "string",
+					"format": "date-time"
+				},
+				"color": {
+					"oneOf": [
+						{
+							"$ref": "#/$defs/Color"
+						},
+						{
+							"type": "null"
+						}
+					]
+				},
+				"name": {
+					"$ref": "#/$defs/Name",
+					"maxLength": 64
+				},
+				"prices": {
+					"type": "object",
+					"propertyNames": {
+						"$ref": "#/$defs/Color"
+					},
+					"additionalProperties": {
+						"$ref": "#/$defs/Price"
+					}
+				},
+				"tags": {
+					"type": "array",
+					"items": {
+						"type": "string"
+					},
+					"minItems": 1
+				}
+			},
+			"required": [
+				"name",
+				"color",
+				"tags",
+				"prices",
+				"added"
+			]
+		},
+		"Name": {
+			"type": "string"
+		},
+		"Price": {
+			"oneOf": [
+				{
+					"$ref": "#/$defs/Cents"
+				},
+				{
+					"type": "string"
+				}
+			]
+		}
+	}
+}
+`, string(doc))
+}
+
+// TestJSONSchemaDuration tests exporting durations
+// in the Go duration format
+func TestJSONSchemaDuration(t *testing.T) {
+	doc, err := openapi.JSONSchema(gapitest.Compile(t, `schema s
+struct T {
+	a Duration
+	# b is documented
+	b Duration
+}
+query t T`))
+	require.NoError(t, err)
+	require.Equal(t, `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "s",
+	"$defs": {
+		"T": {
+			"type": "object",
+			"properties": {
+				"a": {
+					"type": "string",
+					"format": "go-duration",
+					"description": "duration in the Go format such as 1h30m"
+				},
+				"b": {
+					"type": "string",
+					"format": "go-duration",
+					"description": "b is documented"
+				}
+			},
+			"required": [
+				"a",
+				"b"
+			]
+		}
+	}
+}
+`, string(doc))
+}
+
+// TestExport tests exporting OpenAPI documents
+func TestExport(t *testing.T) {
+	encoded, issues, err := openapi.Export(
+		gapitest.Compile(t, testSchema),
+		openapi.Options{},
+	)
+	require.NoError(t, err)
+
+	doc := openapi.Document{}
+	require.NoError(t, json.Unmarshal(encoded, &doc))
+	require.Equal(t, openapi.OpenAPIVersion, doc.OpenAPI)
+	require.Equal(t, openapi.Info{
+		Title:   "shop",
+		Version: openapi.DefaultAPIVersion,
+	}, doc.Info)
+	require.Len(t, doc.Paths, 3)
+	require.Len(t, doc.Components.Schemas, 5)
+	require.Equal(t, "#/components/schemas/Name",
+		doc.Components.Schemas["Item"].Properties["name"].Ref)
+
+	// Query with optional and default parameters
+	items := doc.Paths["/query/items"].Post
+	require.NotNil(t, items)
+	require.Equal(t, "items", items.OperationID)
+	require.Equal(t, []string{"query"}, items.Tags)
+	require.Equal(t, "items returns all items", items.Description)
+	require.False(t, items.Deprecated)
+	require.False(t, items.RequestBody.Required)
+	body := items.RequestBody.Content["application/json"].Schema
+	require.Equal(t, "object", body.Type)
+	require.Nil(t, body.Required)
+	require.Len(t, body.Properties, 2)
+	require.Equal(t,
+		"limit limits the number of items",
+		body.Properties["limit"].Description,
+	)
+	require.Equal(t, "10", body.Properties["limit"].Default.Literal)
+	require.Equal(t, &openapi.Schema{
+		Type: "array",
+		Items: &openapi.Schema{
+			Ref: "#/components/schemas/Item",
+		},
+	}, items.Responses["200"].Content["application/json"].Schema)
+
+	// Query without parameters and an impure result
+	shop := doc.Paths["/query/shop"].Post
+	require.NotNil(t, shop)
+	require.Nil(t, shop.RequestBody)
+	require.Nil(t, shop.Responses["200"].Content)
+
+	// Deprecated mutation with a required parameter
+	addItem := doc.Paths["/mutation/addItem"].Post
+	require.NotNil(t, addItem)
+	require.Equal(t, []string{"mutation"}, addItem.Tags)
+	require.True(t, addItem.Deprecated)
+	require.True(t, addItem.RequestBody.Required)
+	body = addItem.RequestBody.Content["application/json"].Schema
+	require.Equal(t, []string{"item"}, body.Required)
+
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.String()
+	}
+	require.Equal(t, []string{
+		"query shop: result type Shop is impure and can't be described",
+		"mutation addItem: result type ResItem is impure " +
+			"and can't be described",
+		"subscription added: subscriptions can't be described",
+	}, messages)
+}
+
+// TestExportExample tests exporting the example schema
+func TestExportExample(t *testing.T) {
+	src, err := ioutil.ReadFile("../example/filesystem.gapi")
+	require.NoError(t, err)
+	mod := gapitest.Compile(t, string(src))
+
+	encoded, _, err := openapi.Export(mod, openapi.Options{
+		APIVersion: "2.0.0",
+	})
+	require.NoError(t, err)
+	doc := openapi.Document{}
+	require.NoError(t, json.Unmarshal(encoded, &doc))
+	require.Equal(t, "2.0.0", doc.Info.Version)
+	require.Len(t, doc.Paths, len(mod.QueryEndpoints)+len(mod.Mutations))
+	require.Contains(t, doc.Components.Schemas, "DirectoryType")
+	require.NotContains(t, doc.Components.Schemas, "User")
+
+	encoded, err = openapi.JSONSchema(mod)
+	require.NoError(t, err)
+	require.True(t, json.Valid(encoded))
+}
@@ -0,0 +1,340 @@
+package openapi
+
+import (
+	"encoding/json"
+
+	"github.com/romshark/gapi/compiler/parser"
+	"github.com/romshark/gapi/generator"
+)
+
+// JSONSchemaDialect is the JSON Schema dialect of the exported schemas
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema represents a JSON Schema
+type Schema struct {
+	Ref           string             `json:"$ref,omitempty"`
+	Type          string             `json:"type,omitempty"`
+	Format        string             `json:"format,omitempty"`
+	Description   string             `json:"description,omitempty"`
+	Deprecated    bool               `json:"deprecated,omitempty"`
+	Enum          []string           `json:"enum,omitempty"`
+	OneOf         []*Schema          `json:"oneOf,omitempty"`
+	Items         *Schema            `json:"items,omitempty"`
+	Properties    map[string]*Schema `json:"properties,omitempty"`
+	Required      []string           `json:"required,omitempty"`
+	PropertyNames *Schema            `json:"propertyNames,omitempty"`
+	Values        *Schema            `json:"additionalProperties,omitempty"`
+	Minimum       *parser.Value      `json:"minimum,omitempty"`
+	Maximum       *parser.Value      `json:"maximum,omitempty"`
+	MinLength     *parser.Value      `json:"minLength,omitempty"`
+	MaxLength     *parser.Value      `json:"maxLength,omitempty"`
+	MinItems      *parser.Value      `json:"minItems,omitempty"`
+	MaxItems      *parser.Value      `json:"maxItems,omitempty"`
+	MinProperties *parser.Value      `json:"minProperties,omitempty"`
+	MaxProperties *parser.Value      `json:"maxProperties,omitempty"`
+	Pattern       string             `json:"pattern,omitempty"`
+	Default       *parser.Value      `json:"default,omitempty"`
+}
+
+// jsonSchemaDocument represents a JSON Schema document
+// defining all pure types of a schema model
+type jsonSchemaDocument struct {
+	Schema      string             `json:"$schema"`
+	Title       string             `json:"title"`
+	Definitions map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// JSONSchema returns a JSON Schema document defining all pure types
+// of the given schema model. Returns an error if the names
+// of the definitions collide
+func JSONSchema(mod *parser.SchemaModel) ([]byte, error) {
+	defs, err := definitions(mod, "#/$defs/")
+	if err != nil {
+		return nil, err
+	}
+	return encode(jsonSchemaDocument{
+		Schema:      JSONSchemaDialect,
+		Title:       mod.SchemaName,
+		Definitions: defs,
+	})
+}
+
+// encode encodes the given document as indented JSON
+func encode(document interface{}) ([]byte, error) {
+	encoded, err := json.MarshalIndent(document, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(encoded, '\n'), nil
+}
+
+// number returns a number value of the given literal
+func number(literal string) *parser.Value {
+	return &parser.Value{Kind: parser.ValueKindNumber, Literal: literal}
+}
+
+// definitions returns the schemas of all pure declared types by name
+// referencing each other by the given reference prefix
+func definitions(
+	mod *parser.SchemaModel,
+	refPrefix string,
+) (map[string]*Schema, error) {
+	b := schemaBuilder{refPrefix: refPrefix}
+	idents := make(generator.Identifiers)
+	defs := map[string]*Schema{}
+	for _, types := range [][]parser.Type{
+		mod.AliasTypes,
+		mod.ScalarTypes,
+		mod.EnumTypes,
+		mod.UnionTypes,
+		mod.StructTypes,
+		mod.TraitTypes,
+	} {
+		for _, t := range types {
+			if !t.IsPure() {
+				continue
+			}
+			name := generator.TypeName(t)
+			if err := idents.Declare(name, "type "+t.String()); err != nil {
+				return nil, err
+			}
+			defs[name] = b.definition(t)
+		}
+	}
+	return defs, nil
+}
+
+// schemaBuilder builds the schemas of types
+type schemaBuilder struct {
+	// refPrefix prefixes the names of referenced definitions
+	refPrefix string
+}
+
+// definition returns the schema defining the given declared type
+func (b schemaBuilder) definition(t parser.Type) *Schema {
+	var s *Schema
+	switch v := t.(type) {
+	case *parser.TypeAlias:
+		s = b.schema(v.AliasedType)
+		describe(s, v.Doc)
+		s.Deprecated = v.Deprecated != nil
+	case *parser.TypeScalar:
+		s = b.schema(v.BaseType)
+		b.constrain(s, v.BaseType, v.Constraints)
+		describe(s, v.Doc)
+		s.Deprecated = v.Deprecated != nil
+	case *parser.TypeEnum:
+		s = &Schema{Type: "string", Enum: make([]string, len(v.Values))}
+		for i, val := range v.Values {
+			s.Enum[i] = val.Name
+		}
+		describe(s, v.Doc)
+		s.Deprecated = v.Deprecated != nil
+	case *parser.TypeUnion:
+		s = &Schema{OneOf: make([]*Schema, len(v.Types))}
+		for i, opt := range v.Types {
+			s.OneOf[i] = b.schema(opt)
+		}
+		describe(s, v.Doc)
+		s.Deprecated = v.Deprecated != nil
+	case *parser.TypeStruct:
+		s = &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema, len(v.Fields)),
+			Required:   make([]string, len(v.Fields)),
+		}
+		for i, fld := range v.Fields {
+			prop := b.schema(fld.Type)
+			b.constrain(prop, fld.Type, fld.Constraints)
+			describe(prop, fld.Doc)
+			prop.Deprecated = fld.Deprecated != nil
+			s.Properties[fld.Name] = prop
+			s.Required[i] = fld.Name
+		}
+		describe(s, v.Doc)
+		s.Deprecated = v.Deprecated != nil
+	case *parser.TypeTrait:
+		s = &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema, len(v.Properties)),
+			Required:   make([]string, len(v.Properties)),
+		}
+		for i, prop := range v.Properties {
+			propSchema := b.schema(prop.Type)
+			describe(propSchema, prop.Doc)
+			propSchema.Deprecated = prop.Deprecated != nil
+			s.Properties[prop.Name] = propSchema
+			s.Required[i] = prop.Name
+		}
+		describe(s, v.Doc)
+		s.Deprecated = v.Deprecated != nil
+	}
+	return s
+}
+
+// describe sets the description of the given schema to the given
+// documentation unless it's empty, in which case the description
+// of the underlying type is kept
+func describe(s *Schema, doc string) {
+	if doc != "" {
+		s.Description = doc
+	}
+}
+
+// schema returns the schema of the given type
+// referencing the definitions of declared types
+func (b schemaBuilder) schema(t parser.Type) *Schema {
+	switch v := t.(type) {
+	case *parser.TypeOptional:
+		return &Schema{OneOf: []*Schema{
+			b.schema(v.StoreType),
+			{Type: "null"},
+		}}
+	case *parser.TypeList:
+		return &Schema{Type: "array", Items: b.schema(v.StoreType)}
+	case *parser.TypeMap:
+		// Object keys are always strings,
+		// enum keys are constrained to their values
+		s := &Schema{Type: "object", Values: b.schema(v.StoreType)}
+		key := generator.Unaliased(v.KeyType)
+		if _, isEnum := key.(*parser.TypeEnum); isEnum {
+			s.PropertyNames = b.schema(v.KeyType)
+		}
+		return s
+	case *parser.TypeAlias,
+		*parser.TypeScalar,
+		*parser.TypeEnum,
+		*parser.TypeUnion,
+		*parser.TypeStruct,
+		*parser.TypeTrait,
+		*parser.TypeResolver:
+		return &Schema{Ref: b.refPrefix + generator.TypeName(t)}
+	}
+	return primitive(t)
+}
+
+// primitive returns the schema of the given primitive type
+func primitive(t parser.Type) *Schema {
+	switch t.(type) {
+	case parser.TypeStdNone:
+		return &Schema{Type: "null"}
+	case parser.TypeStdBool:
+		return &Schema{Type: "boolean"}
+	case parser.TypeStdByte, parser.TypeStdUint8:
+		return &Schema{
+			Type:    "integer",
+			Minimum: number("0"),
+			Maximum: number("255"),
+		}
+	case parser.TypeStdInt8:
+		return &Schema{
+			Type:    "integer",
+			Minimum: number("-128"),
+			Maximum: number("127"),
+		}
+	case parser.TypeStdInt16:
+		return &Schema{
+			Type:    "integer",
+			Minimum: number("-32768"),
+			Maximum: number("32767"),
+		}
+	case parser.TypeStdUint16:
+		return &Schema{
+			Type:    "integer",
+			Minimum: number("0"),
+			Maximum: number("65535"),
+		}
+	case parser.TypeStdInt32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case parser.TypeStdInt64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case parser.TypeStdUint32:
+		return &Schema{
+			Type:    "integer",
+			Minimum: number("0"),
+			Maximum: number("4294967295"),
+		}
+	case parser.TypeStdUint64:
+		return &Schema{Type: "integer", Minimum: number("0")}
+	case parser.TypeStdFloat32:
+		return &Schema{Type: "number", Format: "float"}
+	case parser.TypeStdFloat64:
+		return &Schema{Type: "number", Format: "double"}
+	case parser.TypeStdTime:
+		return &Schema{Type: "string", Format: "date-time"}
+	case parser.TypeStdDate:
+		return &Schema{Type: "string", Format: "date"}
+	case parser.TypeStdDuration:
+		// The standard "duration" format denotes ISO 8601 durations
+		// while durations are written in the Go format (e.g. 1h30m)
+		return &Schema{
+			Type:        "string",
+			Format:      "go-duration",
+			Description: "duration in the Go format such as 1h30m",
+		}
+	case parser.TypeStdUUID:
+		return &Schema{Type: "string", Format: "uuid"}
+	case parser.TypeStdDecimal:
+		// See parser.TypeStdDecimal
+		return &Schema{Type: "string", Format: "decimal"}
+	}
+	return &Schema{Type: "string"}
+}
+
+// constrain applies the given constraints of a value
+// of the given type to its schema
+func (b schemaBuilder) constrain(
+	s *Schema,
+	t parser.Type,
+	constraints []*parser.Constraint,
+) {
+	for _, c := range constraints {
+		switch c.Kind {
+		case parser.ConstraintMin:
+			s.Minimum = c.Value
+		case parser.ConstraintMax:
+			s.Maximum = c.Value
+		case parser.ConstraintPattern:
+			s.Pattern = c.Value.Literal
+		case parser.ConstraintMinLength:
+			*lengthBound(s, t, true) = c.Value
+		case parser.ConstraintMaxLength:
+			*lengthBound(s, t, false) = c.Value
+		case parser.ConstraintNonEmpty:
+			*lengthBound(s, t, true) = number("1")
+		}
+	}
+}
+
+// lengthBound returns the minimum or maximum length keyword
+// of the given schema applying to values of the given type
+// which are either strings, lists or maps
+func lengthBound(s *Schema, t parser.Type, min bool) **parser.Value {
+	for {
+		switch v := t.(type) {
+		case *parser.TypeAlias:
+			t = v.AliasedType
+			continue
+		case *parser.TypeScalar:
+			t = v.BaseType
+			continue
+		case *parser.TypeOptional:
+			t = v.StoreType
+			continue
+		case *parser.TypeList:
+			if min {
+				return &s.MinItems
+			}
+			return &s.MaxItems
+		case *parser.TypeMap:
+			if min {
+				return &s.MinProperties
+			}
+			return &s.MaxProperties
+		}
+		if min {
+			return &s.MinLength
+		}
+		return &s.MaxLength
+	}
+}